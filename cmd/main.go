@@ -6,6 +6,7 @@ import (
 	"product_service/config"
 	"product_service/grpc"
 	"product_service/grpc/client"
+	"product_service/grpc/service"
 	"product_service/pkg/logger"
 	"product_service/storage/postgres"
 
@@ -43,6 +44,12 @@ func main() {
 		log.Panic("client.NewGrpcClients", logger.Error(err))
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go service.NewPriceScheduler(cfg, log, pgStore).Run(ctx)
+	go service.NewStockSnapshotScheduler(cfg, log, pgStore).Run(ctx)
+
 	grpcServer := grpc.SetUpServer(cfg, log, pgStore, svcs)
 
 	lis, err := net.Listen("tcp", cfg.ServicePort)
@@ -3,6 +3,10 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
+	// the time zone database is embedded so that STORE_TIME_ZONE resolves in images without one
+	_ "time/tzdata"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -28,6 +32,9 @@ type Config struct {
 	UserServiceHost string
 	UserServicePort string
 
+	OrganizationServiceHost string
+	OrganizationServicePort string
+
 	PostgresHost     string
 	PostgresPort     int
 	PostgresUser     string
@@ -35,6 +42,21 @@ type Config struct {
 	PostgresDatabase string
 
 	PostgresMaxConnections int32
+
+	// AuthSecret verifies the access tokens of the callers, without it every call is anonymous
+	AuthSecret string
+
+	PriceSchedulerInterval time.Duration
+	StockSnapshotInterval  time.Duration
+
+	BaseCurrency     string
+	CurrencyRounding map[string]float64
+
+	// AllowNegativeStock is used for stores without their own stock setting
+	AllowNegativeStock bool
+
+	// StoreLocation is the time zone of the stores, daily sale hours are clock times in it
+	StoreLocation *time.Location
 }
 
 // Load ...
@@ -55,6 +77,9 @@ func Load() Config {
 	config.UserServiceHost = cast.ToString(getOrReturnDefaultValue("USER_SERVICE_HOST", "localhost"))
 	config.UserServicePort = cast.ToString(getOrReturnDefaultValue("USER_SERVICE_PORT", ":9092"))
 
+	config.OrganizationServiceHost = cast.ToString(getOrReturnDefaultValue("ORGANIZATION_SERVICE_HOST", "localhost"))
+	config.OrganizationServicePort = cast.ToString(getOrReturnDefaultValue("ORGANIZATION_SERVICE_PORT", ":9091"))
+
 	config.PostgresHost = cast.ToString(getOrReturnDefaultValue("POSTGRES_HOST", "0.0.0.0"))
 	config.PostgresPort = cast.ToInt(getOrReturnDefaultValue("POSTGRES_PORT", 5432))
 	config.PostgresUser = cast.ToString(getOrReturnDefaultValue("POSTGRES_USER", "abdurahmon"))
@@ -63,6 +88,18 @@ func Load() Config {
 
 	config.PostgresMaxConnections = cast.ToInt32(getOrReturnDefaultValue("POSTGRES_MAX_CONNECTIONS", 30))
 
+	config.AuthSecret = cast.ToString(getOrReturnDefaultValue("AUTH_SECRET", ""))
+
+	config.PriceSchedulerInterval = parseInterval(cast.ToString(getOrReturnDefaultValue("PRICE_SCHEDULER_INTERVAL", "1m")), time.Minute)
+	config.StockSnapshotInterval = parseInterval(cast.ToString(getOrReturnDefaultValue("STOCK_SNAPSHOT_INTERVAL", "15m")), 15*time.Minute)
+
+	config.BaseCurrency = cast.ToString(getOrReturnDefaultValue("BASE_CURRENCY", "UZS"))
+	config.CurrencyRounding = parseCurrencyRounding(cast.ToString(getOrReturnDefaultValue("CURRENCY_ROUNDING", "UZS:100,USD:0.01,RUB:0.01")))
+
+	config.AllowNegativeStock = cast.ToBool(getOrReturnDefaultValue("ALLOW_NEGATIVE_STOCK", false))
+
+	config.StoreLocation = parseLocation(cast.ToString(getOrReturnDefaultValue("STORE_TIME_ZONE", "Asia/Tashkent")))
+
 	return config
 }
 
@@ -75,3 +112,43 @@ func getOrReturnDefaultValue(key string, defaultValue interface{}) interface{} {
 
 	return defaultValue
 }
+
+// parseCurrencyRounding reads the rounding step of converted prices per currency, e.g. "UZS:100,USD:0.01"
+func parseCurrencyRounding(value string) map[string]float64 {
+	rounding := make(map[string]float64)
+
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		step := cast.ToFloat64(parts[1])
+		if step > 0 {
+			rounding[strings.ToUpper(parts[0])] = step
+		}
+	}
+
+	return rounding
+}
+
+// parseInterval reads a ticker interval and falls back to the default when it is not a positive duration
+func parseInterval(value string, defaultValue time.Duration) time.Duration {
+	interval := cast.ToDuration(value)
+	if interval <= 0 {
+		return defaultValue
+	}
+
+	return interval
+}
+
+// parseLocation reads the IANA time zone of the stores and falls back to UTC when it is unknown
+func parseLocation(value string) *time.Location {
+	location, err := time.LoadLocation(value)
+	if err != nil {
+		fmt.Println("unknown STORE_TIME_ZONE " + value + ", using UTC")
+		return time.UTC
+	}
+
+	return location
+}
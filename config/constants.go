@@ -3,11 +3,82 @@ package config
 import "time"
 
 const (
-	DatabaseQueryTimeLayout string = `'YYYY-MM-DD"T"HH24:MI:SS"."MS"Z"TZ'`
-	DatabaseTimeLayout      string = time.RFC3339
-	ErrTheSameId                   = "cannot use the same uuid for 'id' and 'parent_id' fields"
-	ErrRpcNodFoundAndNoRows        = "rpc error: code = NotFound desc = no rows in result set"
-	ErrNoRows                      = "no rows in result set"
-	ErrObjectType                  = "object type error: code =  NodFound"
-	ErrEnvNodFound                 = "No .env file found"
+	DatabaseQueryTimeLayout   string = `'YYYY-MM-DD"T"HH24:MI:SS"."MS"Z"TZ'`
+	DatabaseTimeLayout        string = time.RFC3339
+	ErrTheSameId                     = "cannot use the same uuid for 'id' and 'parent_id' fields"
+	ErrRpcNodFoundAndNoRows          = "rpc error: code = NotFound desc = no rows in result set"
+	ErrNoRows                        = "no rows in result set"
+	ErrObjectType                    = "object type error: code =  NodFound"
+	ErrEnvNodFound                   = "No .env file found"
+	ErrVersionRequired               = "version is required"
+	ErrStaleVersion                  = "version mismatch: current version is %d"
+	ErrCostAccessDenied              = "cost data is available to managers only"
+	ErrMarkingCodeRequired           = "marking code is required"
+	ErrMarkingGtinMismatch           = "marking code GTIN does not match the product GTIN or barcode"
+	ErrMarkingProductNotFound        = "no product matches the marking code GTIN"
+	ErrBundleIsComponent             = "product is a component of another bundle and cannot become a bundle"
+	ErrInvalidGtin                   = "gtin must be a GTIN-8, 12, 13 or 14 with a valid check digit"
+	ErrInvalidCurrency               = "currency must be a three letter ISO 4217 code"
+	ErrNoExchangeRate                = "no exchange rate for currency %s"
+	ErrNoComponentRate               = "no exchange rate from a component currency to the bundle currency"
+	ErrProductNotOrdered             = "product %s is not on the purchase order"
+
+	PriceChangePending = "pending"
+	PriceChangeApplied = "applied"
+
+	PriceSourcePriceList = "price_list"
+	PriceSourceBase      = "base"
+	PriceSourceFilial    = "filial"
+	PriceSourceMagazin   = "magazin"
+
+	PriceSourceBundle = "bundle"
+
+	BundlePricingFixed            = "fixed"
+	BundlePricingSumMinusDiscount = "sum_minus_discount"
+
+	RelationTypeSubstitute = "substitute"
+	RelationTypeAccessory  = "accessory"
+	RelationTypeUpsell     = "upsell"
+	RelationTypeCrossSell  = "cross_sell"
+
+	TemperatureClassAmbient = "ambient"
+	TemperatureClassChilled = "chilled"
+	TemperatureClassFrozen  = "frozen"
+
+	DefaultWeightUnit    = "kg"
+	DefaultDimensionUnit = "cm"
+
+	MovementTypeReceipt    = "receipt"
+	MovementTypeSale       = "sale"
+	MovementTypeReturn     = "return"
+	MovementTypeWriteOff   = "write_off"
+	MovementTypeTransfer   = "transfer"
+	MovementTypeAdjustment = "adjustment"
+
+	DocumentStatusDraft  = "draft"
+	DocumentStatusPosted = "posted"
+
+	PurchaseOrderStatusSent              = "sent"
+	PurchaseOrderStatusPartiallyReceived = "partially_received"
+	PurchaseOrderStatusReceived          = "received"
+	PurchaseOrderStatusCancelled         = "cancelled"
+
+	ReconciliationMatched  = "matched"
+	ReconciliationShortage = "shortage"
+	ReconciliationOverage  = "overage"
+
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+
+	StaffTypeAdmin   = "admin"
+	StaffTypeManager = "manager"
+
+	// DefaultUnitId is the piece unit that products without a unit are sold in
+	DefaultUnitId = "00000000-0000-0000-0000-000000000001"
+
+	// DefaultDuplicateScore is the lowest score FindDuplicates reports when the caller does not ask for another
+	DefaultDuplicateScore = 0.5
+
+	// DefaultCurrencyRounding is the rounding step of converted prices in currencies without a configured one
+	DefaultCurrencyRounding = 0.01
 )
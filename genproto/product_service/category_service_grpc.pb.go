@@ -25,6 +25,7 @@ type CategoryServiceClient interface {
 	Update(ctx context.Context, in *UpdateCategory, opts ...grpc.CallOption) (*Category, error)
 	UpdatePatch(ctx context.Context, in *UpdatePatchCategory, opts ...grpc.CallOption) (*Category, error)
 	Delete(ctx context.Context, in *CategoryPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListCategoryHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error)
 }
 
 type categoryServiceClient struct {
@@ -89,6 +90,15 @@ func (c *categoryServiceClient) Delete(ctx context.Context, in *CategoryPK, opts
 	return out, nil
 }
 
+func (c *categoryServiceClient) ListCategoryHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error) {
+	out := new(ListHistoryResponse)
+	err := c.cc.Invoke(ctx, "/product_service.CategoryService/ListCategoryHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CategoryServiceServer is the server API for CategoryService service.
 // All implementations must embed UnimplementedCategoryServiceServer
 // for forward compatibility
@@ -99,6 +109,7 @@ type CategoryServiceServer interface {
 	Update(context.Context, *UpdateCategory) (*Category, error)
 	UpdatePatch(context.Context, *UpdatePatchCategory) (*Category, error)
 	Delete(context.Context, *CategoryPK) (*empty.Empty, error)
+	ListCategoryHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
 	mustEmbedUnimplementedCategoryServiceServer()
 }
 
@@ -124,6 +135,9 @@ func (UnimplementedCategoryServiceServer) UpdatePatch(context.Context, *UpdatePa
 func (UnimplementedCategoryServiceServer) Delete(context.Context, *CategoryPK) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedCategoryServiceServer) ListCategoryHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryHistory not implemented")
+}
 func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
 
 // UnsafeCategoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -245,6 +259,24 @@ func _CategoryService_Delete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CategoryService_ListCategoryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).ListCategoryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.CategoryService/ListCategoryHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).ListCategoryHistory(ctx, req.(*ListHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -276,6 +308,10 @@ var CategoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _CategoryService_Delete_Handler,
 		},
+		{
+			MethodName: "ListCategoryHistory",
+			Handler:    _CategoryService_ListCategoryHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "category_service.proto",
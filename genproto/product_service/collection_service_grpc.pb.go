@@ -0,0 +1,318 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package product_service
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CollectionServiceClient is the client API for CollectionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CollectionServiceClient interface {
+	Create(ctx context.Context, in *CreateCollection, opts ...grpc.CallOption) (*Collection, error)
+	GetByID(ctx context.Context, in *CollectionPK, opts ...grpc.CallOption) (*Collection, error)
+	GetList(ctx context.Context, in *GetListCollectionRequest, opts ...grpc.CallOption) (*GetListCollectionResponse, error)
+	Update(ctx context.Context, in *UpdateCollection, opts ...grpc.CallOption) (*Collection, error)
+	Delete(ctx context.Context, in *CollectionPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListCollectionProducts(ctx context.Context, in *ListCollectionProductsRequest, opts ...grpc.CallOption) (*GetListProductResponse, error)
+	PreviewCollection(ctx context.Context, in *PreviewCollectionRequest, opts ...grpc.CallOption) (*GetListProductResponse, error)
+}
+
+type collectionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCollectionServiceClient(cc grpc.ClientConnInterface) CollectionServiceClient {
+	return &collectionServiceClient{cc}
+}
+
+func (c *collectionServiceClient) Create(ctx context.Context, in *CreateCollection, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, "/product_service.CollectionService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetByID(ctx context.Context, in *CollectionPK, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, "/product_service.CollectionService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetList(ctx context.Context, in *GetListCollectionRequest, opts ...grpc.CallOption) (*GetListCollectionResponse, error) {
+	out := new(GetListCollectionResponse)
+	err := c.cc.Invoke(ctx, "/product_service.CollectionService/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) Update(ctx context.Context, in *UpdateCollection, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, "/product_service.CollectionService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) Delete(ctx context.Context, in *CollectionPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.CollectionService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListCollectionProducts(ctx context.Context, in *ListCollectionProductsRequest, opts ...grpc.CallOption) (*GetListProductResponse, error) {
+	out := new(GetListProductResponse)
+	err := c.cc.Invoke(ctx, "/product_service.CollectionService/ListCollectionProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) PreviewCollection(ctx context.Context, in *PreviewCollectionRequest, opts ...grpc.CallOption) (*GetListProductResponse, error) {
+	out := new(GetListProductResponse)
+	err := c.cc.Invoke(ctx, "/product_service.CollectionService/PreviewCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollectionServiceServer is the server API for CollectionService service.
+// All implementations must embed UnimplementedCollectionServiceServer
+// for forward compatibility
+type CollectionServiceServer interface {
+	Create(context.Context, *CreateCollection) (*Collection, error)
+	GetByID(context.Context, *CollectionPK) (*Collection, error)
+	GetList(context.Context, *GetListCollectionRequest) (*GetListCollectionResponse, error)
+	Update(context.Context, *UpdateCollection) (*Collection, error)
+	Delete(context.Context, *CollectionPK) (*empty.Empty, error)
+	ListCollectionProducts(context.Context, *ListCollectionProductsRequest) (*GetListProductResponse, error)
+	PreviewCollection(context.Context, *PreviewCollectionRequest) (*GetListProductResponse, error)
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+// UnimplementedCollectionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCollectionServiceServer struct {
+}
+
+func (UnimplementedCollectionServiceServer) Create(context.Context, *CreateCollection) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetByID(context.Context, *CollectionPK) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetList(context.Context, *GetListCollectionRequest) (*GetListCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedCollectionServiceServer) Update(context.Context, *UpdateCollection) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedCollectionServiceServer) Delete(context.Context, *CollectionPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListCollectionProducts(context.Context, *ListCollectionProductsRequest) (*GetListProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionProducts not implemented")
+}
+func (UnimplementedCollectionServiceServer) PreviewCollection(context.Context, *PreviewCollectionRequest) (*GetListProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
+
+// UnsafeCollectionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollectionServiceServer will
+// result in compilation errors.
+type UnsafeCollectionServiceServer interface {
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+func RegisterCollectionServiceServer(s grpc.ServiceRegistrar, srv CollectionServiceServer) {
+	s.RegisterService(&CollectionService_ServiceDesc, srv)
+}
+
+func _CollectionService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.CollectionService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).Create(ctx, req.(*CreateCollection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.CollectionService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetByID(ctx, req.(*CollectionPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.CollectionService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetList(ctx, req.(*GetListCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.CollectionService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).Update(ctx, req.(*UpdateCollection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.CollectionService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).Delete(ctx, req.(*CollectionPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListCollectionProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListCollectionProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.CollectionService/ListCollectionProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListCollectionProducts(ctx, req.(*ListCollectionProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_PreviewCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).PreviewCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.CollectionService/PreviewCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).PreviewCollection(ctx, req.(*PreviewCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CollectionService_ServiceDesc is the grpc.ServiceDesc for CollectionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CollectionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product_service.CollectionService",
+	HandlerType: (*CollectionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _CollectionService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _CollectionService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _CollectionService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CollectionService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CollectionService_Delete_Handler,
+		},
+		{
+			MethodName: "ListCollectionProducts",
+			Handler:    _CollectionService_ListCollectionProducts_Handler,
+		},
+		{
+			MethodName: "PreviewCollection",
+			Handler:    _CollectionService_PreviewCollection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "collection_service.proto",
+}
@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package product_service
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GoodsReceiptServiceClient is the client API for GoodsReceiptService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GoodsReceiptServiceClient interface {
+	Create(ctx context.Context, in *CreateGoodsReceipt, opts ...grpc.CallOption) (*GoodsReceipt, error)
+	GetByID(ctx context.Context, in *GoodsReceiptPK, opts ...grpc.CallOption) (*GoodsReceipt, error)
+	GetList(ctx context.Context, in *GetListGoodsReceiptRequest, opts ...grpc.CallOption) (*GetListGoodsReceiptResponse, error)
+	Update(ctx context.Context, in *UpdateGoodsReceipt, opts ...grpc.CallOption) (*GoodsReceipt, error)
+	Delete(ctx context.Context, in *GoodsReceiptPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	Post(ctx context.Context, in *PostGoodsReceiptRequest, opts ...grpc.CallOption) (*GoodsReceipt, error)
+}
+
+type goodsReceiptServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGoodsReceiptServiceClient(cc grpc.ClientConnInterface) GoodsReceiptServiceClient {
+	return &goodsReceiptServiceClient{cc}
+}
+
+func (c *goodsReceiptServiceClient) Create(ctx context.Context, in *CreateGoodsReceipt, opts ...grpc.CallOption) (*GoodsReceipt, error) {
+	out := new(GoodsReceipt)
+	err := c.cc.Invoke(ctx, "/product_service.GoodsReceiptService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsReceiptServiceClient) GetByID(ctx context.Context, in *GoodsReceiptPK, opts ...grpc.CallOption) (*GoodsReceipt, error) {
+	out := new(GoodsReceipt)
+	err := c.cc.Invoke(ctx, "/product_service.GoodsReceiptService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsReceiptServiceClient) GetList(ctx context.Context, in *GetListGoodsReceiptRequest, opts ...grpc.CallOption) (*GetListGoodsReceiptResponse, error) {
+	out := new(GetListGoodsReceiptResponse)
+	err := c.cc.Invoke(ctx, "/product_service.GoodsReceiptService/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsReceiptServiceClient) Update(ctx context.Context, in *UpdateGoodsReceipt, opts ...grpc.CallOption) (*GoodsReceipt, error) {
+	out := new(GoodsReceipt)
+	err := c.cc.Invoke(ctx, "/product_service.GoodsReceiptService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsReceiptServiceClient) Delete(ctx context.Context, in *GoodsReceiptPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.GoodsReceiptService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsReceiptServiceClient) Post(ctx context.Context, in *PostGoodsReceiptRequest, opts ...grpc.CallOption) (*GoodsReceipt, error) {
+	out := new(GoodsReceipt)
+	err := c.cc.Invoke(ctx, "/product_service.GoodsReceiptService/Post", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoodsReceiptServiceServer is the server API for GoodsReceiptService service.
+// All implementations must embed UnimplementedGoodsReceiptServiceServer
+// for forward compatibility
+type GoodsReceiptServiceServer interface {
+	Create(context.Context, *CreateGoodsReceipt) (*GoodsReceipt, error)
+	GetByID(context.Context, *GoodsReceiptPK) (*GoodsReceipt, error)
+	GetList(context.Context, *GetListGoodsReceiptRequest) (*GetListGoodsReceiptResponse, error)
+	Update(context.Context, *UpdateGoodsReceipt) (*GoodsReceipt, error)
+	Delete(context.Context, *GoodsReceiptPK) (*empty.Empty, error)
+	Post(context.Context, *PostGoodsReceiptRequest) (*GoodsReceipt, error)
+	mustEmbedUnimplementedGoodsReceiptServiceServer()
+}
+
+// UnimplementedGoodsReceiptServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGoodsReceiptServiceServer struct {
+}
+
+func (UnimplementedGoodsReceiptServiceServer) Create(context.Context, *CreateGoodsReceipt) (*GoodsReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGoodsReceiptServiceServer) GetByID(context.Context, *GoodsReceiptPK) (*GoodsReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedGoodsReceiptServiceServer) GetList(context.Context, *GetListGoodsReceiptRequest) (*GetListGoodsReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedGoodsReceiptServiceServer) Update(context.Context, *UpdateGoodsReceipt) (*GoodsReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGoodsReceiptServiceServer) Delete(context.Context, *GoodsReceiptPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGoodsReceiptServiceServer) Post(context.Context, *PostGoodsReceiptRequest) (*GoodsReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Post not implemented")
+}
+func (UnimplementedGoodsReceiptServiceServer) mustEmbedUnimplementedGoodsReceiptServiceServer() {}
+
+// UnsafeGoodsReceiptServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoodsReceiptServiceServer will
+// result in compilation errors.
+type UnsafeGoodsReceiptServiceServer interface {
+	mustEmbedUnimplementedGoodsReceiptServiceServer()
+}
+
+func RegisterGoodsReceiptServiceServer(s grpc.ServiceRegistrar, srv GoodsReceiptServiceServer) {
+	s.RegisterService(&GoodsReceiptService_ServiceDesc, srv)
+}
+
+func _GoodsReceiptService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoodsReceipt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsReceiptServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.GoodsReceiptService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsReceiptServiceServer).Create(ctx, req.(*CreateGoodsReceipt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsReceiptService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsReceiptPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsReceiptServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.GoodsReceiptService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsReceiptServiceServer).GetByID(ctx, req.(*GoodsReceiptPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsReceiptService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListGoodsReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsReceiptServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.GoodsReceiptService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsReceiptServiceServer).GetList(ctx, req.(*GetListGoodsReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsReceiptService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoodsReceipt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsReceiptServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.GoodsReceiptService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsReceiptServiceServer).Update(ctx, req.(*UpdateGoodsReceipt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsReceiptService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsReceiptPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsReceiptServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.GoodsReceiptService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsReceiptServiceServer).Delete(ctx, req.(*GoodsReceiptPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsReceiptService_Post_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostGoodsReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsReceiptServiceServer).Post(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.GoodsReceiptService/Post",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsReceiptServiceServer).Post(ctx, req.(*PostGoodsReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GoodsReceiptService_ServiceDesc is the grpc.ServiceDesc for GoodsReceiptService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GoodsReceiptService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product_service.GoodsReceiptService",
+	HandlerType: (*GoodsReceiptServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _GoodsReceiptService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _GoodsReceiptService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _GoodsReceiptService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GoodsReceiptService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GoodsReceiptService_Delete_Handler,
+		},
+		{
+			MethodName: "Post",
+			Handler:    _GoodsReceiptService_Post_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "goods_receipt_service.proto",
+}
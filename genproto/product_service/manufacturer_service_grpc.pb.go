@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package product_service
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ManufacturerServiceClient is the client API for ManufacturerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ManufacturerServiceClient interface {
+	Create(ctx context.Context, in *CreateManufacturer, opts ...grpc.CallOption) (*Manufacturer, error)
+	GetByID(ctx context.Context, in *ManufacturerPK, opts ...grpc.CallOption) (*Manufacturer, error)
+	GetList(ctx context.Context, in *GetListManufacturerRequest, opts ...grpc.CallOption) (*GetListManufacturerResponse, error)
+	Update(ctx context.Context, in *UpdateManufacturer, opts ...grpc.CallOption) (*Manufacturer, error)
+	Delete(ctx context.Context, in *ManufacturerPK, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type manufacturerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewManufacturerServiceClient(cc grpc.ClientConnInterface) ManufacturerServiceClient {
+	return &manufacturerServiceClient{cc}
+}
+
+func (c *manufacturerServiceClient) Create(ctx context.Context, in *CreateManufacturer, opts ...grpc.CallOption) (*Manufacturer, error) {
+	out := new(Manufacturer)
+	err := c.cc.Invoke(ctx, "/product_service.ManufacturerService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manufacturerServiceClient) GetByID(ctx context.Context, in *ManufacturerPK, opts ...grpc.CallOption) (*Manufacturer, error) {
+	out := new(Manufacturer)
+	err := c.cc.Invoke(ctx, "/product_service.ManufacturerService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manufacturerServiceClient) GetList(ctx context.Context, in *GetListManufacturerRequest, opts ...grpc.CallOption) (*GetListManufacturerResponse, error) {
+	out := new(GetListManufacturerResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ManufacturerService/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manufacturerServiceClient) Update(ctx context.Context, in *UpdateManufacturer, opts ...grpc.CallOption) (*Manufacturer, error) {
+	out := new(Manufacturer)
+	err := c.cc.Invoke(ctx, "/product_service.ManufacturerService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manufacturerServiceClient) Delete(ctx context.Context, in *ManufacturerPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.ManufacturerService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManufacturerServiceServer is the server API for ManufacturerService service.
+// All implementations must embed UnimplementedManufacturerServiceServer
+// for forward compatibility
+type ManufacturerServiceServer interface {
+	Create(context.Context, *CreateManufacturer) (*Manufacturer, error)
+	GetByID(context.Context, *ManufacturerPK) (*Manufacturer, error)
+	GetList(context.Context, *GetListManufacturerRequest) (*GetListManufacturerResponse, error)
+	Update(context.Context, *UpdateManufacturer) (*Manufacturer, error)
+	Delete(context.Context, *ManufacturerPK) (*empty.Empty, error)
+	mustEmbedUnimplementedManufacturerServiceServer()
+}
+
+// UnimplementedManufacturerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedManufacturerServiceServer struct {
+}
+
+func (UnimplementedManufacturerServiceServer) Create(context.Context, *CreateManufacturer) (*Manufacturer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedManufacturerServiceServer) GetByID(context.Context, *ManufacturerPK) (*Manufacturer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedManufacturerServiceServer) GetList(context.Context, *GetListManufacturerRequest) (*GetListManufacturerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedManufacturerServiceServer) Update(context.Context, *UpdateManufacturer) (*Manufacturer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedManufacturerServiceServer) Delete(context.Context, *ManufacturerPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedManufacturerServiceServer) mustEmbedUnimplementedManufacturerServiceServer() {}
+
+// UnsafeManufacturerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ManufacturerServiceServer will
+// result in compilation errors.
+type UnsafeManufacturerServiceServer interface {
+	mustEmbedUnimplementedManufacturerServiceServer()
+}
+
+func RegisterManufacturerServiceServer(s grpc.ServiceRegistrar, srv ManufacturerServiceServer) {
+	s.RegisterService(&ManufacturerService_ServiceDesc, srv)
+}
+
+func _ManufacturerService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateManufacturer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManufacturerServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ManufacturerService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManufacturerServiceServer).Create(ctx, req.(*CreateManufacturer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManufacturerService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManufacturerPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManufacturerServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ManufacturerService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManufacturerServiceServer).GetByID(ctx, req.(*ManufacturerPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManufacturerService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListManufacturerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManufacturerServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ManufacturerService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManufacturerServiceServer).GetList(ctx, req.(*GetListManufacturerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManufacturerService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateManufacturer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManufacturerServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ManufacturerService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManufacturerServiceServer).Update(ctx, req.(*UpdateManufacturer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManufacturerService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManufacturerPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManufacturerServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ManufacturerService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManufacturerServiceServer).Delete(ctx, req.(*ManufacturerPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ManufacturerService_ServiceDesc is the grpc.ServiceDesc for ManufacturerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ManufacturerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product_service.ManufacturerService",
+	HandlerType: (*ManufacturerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ManufacturerService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _ManufacturerService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _ManufacturerService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ManufacturerService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ManufacturerService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "manufacturer_service.proto",
+}
@@ -0,0 +1,390 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package product_service
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PriceListServiceClient is the client API for PriceListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PriceListServiceClient interface {
+	Create(ctx context.Context, in *CreatePriceList, opts ...grpc.CallOption) (*PriceList, error)
+	GetByID(ctx context.Context, in *PriceListPK, opts ...grpc.CallOption) (*PriceList, error)
+	GetList(ctx context.Context, in *GetListPriceListRequest, opts ...grpc.CallOption) (*GetListPriceListResponse, error)
+	Update(ctx context.Context, in *UpdatePriceList, opts ...grpc.CallOption) (*PriceList, error)
+	Delete(ctx context.Context, in *PriceListPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	CreateItem(ctx context.Context, in *CreatePriceListItem, opts ...grpc.CallOption) (*PriceListItem, error)
+	GetItems(ctx context.Context, in *GetListPriceListItemRequest, opts ...grpc.CallOption) (*GetListPriceListItemResponse, error)
+	DeleteItem(ctx context.Context, in *PriceListItemPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
+}
+
+type priceListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPriceListServiceClient(cc grpc.ClientConnInterface) PriceListServiceClient {
+	return &priceListServiceClient{cc}
+}
+
+func (c *priceListServiceClient) Create(ctx context.Context, in *CreatePriceList, opts ...grpc.CallOption) (*PriceList, error) {
+	out := new(PriceList)
+	err := c.cc.Invoke(ctx, "/product_service.PriceListService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) GetByID(ctx context.Context, in *PriceListPK, opts ...grpc.CallOption) (*PriceList, error) {
+	out := new(PriceList)
+	err := c.cc.Invoke(ctx, "/product_service.PriceListService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) GetList(ctx context.Context, in *GetListPriceListRequest, opts ...grpc.CallOption) (*GetListPriceListResponse, error) {
+	out := new(GetListPriceListResponse)
+	err := c.cc.Invoke(ctx, "/product_service.PriceListService/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) Update(ctx context.Context, in *UpdatePriceList, opts ...grpc.CallOption) (*PriceList, error) {
+	out := new(PriceList)
+	err := c.cc.Invoke(ctx, "/product_service.PriceListService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) Delete(ctx context.Context, in *PriceListPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.PriceListService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) CreateItem(ctx context.Context, in *CreatePriceListItem, opts ...grpc.CallOption) (*PriceListItem, error) {
+	out := new(PriceListItem)
+	err := c.cc.Invoke(ctx, "/product_service.PriceListService/CreateItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) GetItems(ctx context.Context, in *GetListPriceListItemRequest, opts ...grpc.CallOption) (*GetListPriceListItemResponse, error) {
+	out := new(GetListPriceListItemResponse)
+	err := c.cc.Invoke(ctx, "/product_service.PriceListService/GetItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) DeleteItem(ctx context.Context, in *PriceListItemPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.PriceListService/DeleteItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error) {
+	out := new(GetPriceResponse)
+	err := c.cc.Invoke(ctx, "/product_service.PriceListService/GetPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PriceListServiceServer is the server API for PriceListService service.
+// All implementations must embed UnimplementedPriceListServiceServer
+// for forward compatibility
+type PriceListServiceServer interface {
+	Create(context.Context, *CreatePriceList) (*PriceList, error)
+	GetByID(context.Context, *PriceListPK) (*PriceList, error)
+	GetList(context.Context, *GetListPriceListRequest) (*GetListPriceListResponse, error)
+	Update(context.Context, *UpdatePriceList) (*PriceList, error)
+	Delete(context.Context, *PriceListPK) (*empty.Empty, error)
+	CreateItem(context.Context, *CreatePriceListItem) (*PriceListItem, error)
+	GetItems(context.Context, *GetListPriceListItemRequest) (*GetListPriceListItemResponse, error)
+	DeleteItem(context.Context, *PriceListItemPK) (*empty.Empty, error)
+	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
+	mustEmbedUnimplementedPriceListServiceServer()
+}
+
+// UnimplementedPriceListServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPriceListServiceServer struct {
+}
+
+func (UnimplementedPriceListServiceServer) Create(context.Context, *CreatePriceList) (*PriceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedPriceListServiceServer) GetByID(context.Context, *PriceListPK) (*PriceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedPriceListServiceServer) GetList(context.Context, *GetListPriceListRequest) (*GetListPriceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedPriceListServiceServer) Update(context.Context, *UpdatePriceList) (*PriceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPriceListServiceServer) Delete(context.Context, *PriceListPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPriceListServiceServer) CreateItem(context.Context, *CreatePriceListItem) (*PriceListItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItem not implemented")
+}
+func (UnimplementedPriceListServiceServer) GetItems(context.Context, *GetListPriceListItemRequest) (*GetListPriceListItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItems not implemented")
+}
+func (UnimplementedPriceListServiceServer) DeleteItem(context.Context, *PriceListItemPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+}
+func (UnimplementedPriceListServiceServer) GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
+}
+func (UnimplementedPriceListServiceServer) mustEmbedUnimplementedPriceListServiceServer() {}
+
+// UnsafePriceListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PriceListServiceServer will
+// result in compilation errors.
+type UnsafePriceListServiceServer interface {
+	mustEmbedUnimplementedPriceListServiceServer()
+}
+
+func RegisterPriceListServiceServer(s grpc.ServiceRegistrar, srv PriceListServiceServer) {
+	s.RegisterService(&PriceListService_ServiceDesc, srv)
+}
+
+func _PriceListService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PriceListService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).Create(ctx, req.(*CreatePriceList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceListPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PriceListService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).GetByID(ctx, req.(*PriceListPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListPriceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PriceListService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).GetList(ctx, req.(*GetListPriceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PriceListService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).Update(ctx, req.(*UpdatePriceList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceListPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PriceListService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).Delete(ctx, req.(*PriceListPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_CreateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceListItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).CreateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PriceListService/CreateItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).CreateItem(ctx, req.(*CreatePriceListItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_GetItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListPriceListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).GetItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PriceListService/GetItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).GetItems(ctx, req.(*GetListPriceListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceListItemPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).DeleteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PriceListService/DeleteItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).DeleteItem(ctx, req.(*PriceListItemPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_GetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).GetPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PriceListService/GetPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).GetPrice(ctx, req.(*GetPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PriceListService_ServiceDesc is the grpc.ServiceDesc for PriceListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PriceListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product_service.PriceListService",
+	HandlerType: (*PriceListServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _PriceListService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _PriceListService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _PriceListService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PriceListService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PriceListService_Delete_Handler,
+		},
+		{
+			MethodName: "CreateItem",
+			Handler:    _PriceListService_CreateItem_Handler,
+		},
+		{
+			MethodName: "GetItems",
+			Handler:    _PriceListService_GetItems_Handler,
+		},
+		{
+			MethodName: "DeleteItem",
+			Handler:    _PriceListService_DeleteItem_Handler,
+		},
+		{
+			MethodName: "GetPrice",
+			Handler:    _PriceListService_GetPrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "price_list_service.proto",
+}
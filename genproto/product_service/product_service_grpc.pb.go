@@ -25,6 +25,38 @@ type ProductServiceClient interface {
 	Update(ctx context.Context, in *UpdateProduct, opts ...grpc.CallOption) (*Product, error)
 	UpdatePatch(ctx context.Context, in *UpdatePatchProduct, opts ...grpc.CallOption) (*Product, error)
 	Delete(ctx context.Context, in *ProductPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListProductHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*ScheduledPriceChange, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	GetByBarcode(ctx context.Context, in *GetByBarcodeRequest, opts ...grpc.CallOption) (*Product, error)
+	SetPriceOverride(ctx context.Context, in *SetPriceOverrideRequest, opts ...grpc.CallOption) (*PriceOverride, error)
+	GetPriceOverrides(ctx context.Context, in *GetPriceOverridesRequest, opts ...grpc.CallOption) (*GetPriceOverridesResponse, error)
+	DeletePriceOverride(ctx context.Context, in *PriceOverridePK, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetProviderCost(ctx context.Context, in *SetProviderCostRequest, opts ...grpc.CallOption) (*ProviderCost, error)
+	GetProviderCosts(ctx context.Context, in *GetProviderCostsRequest, opts ...grpc.CallOption) (*GetProviderCostsResponse, error)
+	RepriceByMarkup(ctx context.Context, in *RepriceByMarkupRequest, opts ...grpc.CallOption) (*RepriceByMarkupResponse, error)
+	ValidateMarkingCode(ctx context.Context, in *ValidateMarkingCodeRequest, opts ...grpc.CallOption) (*ValidateMarkingCodeResponse, error)
+	SetSaleRestriction(ctx context.Context, in *SetSaleRestrictionRequest, opts ...grpc.CallOption) (*SaleRestriction, error)
+	GetSaleRestrictions(ctx context.Context, in *GetSaleRestrictionsRequest, opts ...grpc.CallOption) (*GetSaleRestrictionsResponse, error)
+	DeleteSaleRestriction(ctx context.Context, in *SaleRestrictionPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error)
+	GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error)
+	SetPackaging(ctx context.Context, in *SetPackagingRequest, opts ...grpc.CallOption) (*Packaging, error)
+	GetPackagings(ctx context.Context, in *GetPackagingsRequest, opts ...grpc.CallOption) (*GetPackagingsResponse, error)
+	DeletePackaging(ctx context.Context, in *PackagingPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetQuantityRule(ctx context.Context, in *ProductPK, opts ...grpc.CallOption) (*QuantityRule, error)
+	ConvertQuantity(ctx context.Context, in *ConvertQuantityRequest, opts ...grpc.CallOption) (*ConvertQuantityResponse, error)
+	TagProducts(ctx context.Context, in *TagProductsRequest, opts ...grpc.CallOption) (*TagProductsResponse, error)
+	UntagProducts(ctx context.Context, in *TagProductsRequest, opts ...grpc.CallOption) (*TagProductsResponse, error)
+	SetBundle(ctx context.Context, in *SetBundleRequest, opts ...grpc.CallOption) (*Bundle, error)
+	GetBundle(ctx context.Context, in *ProductPK, opts ...grpc.CallOption) (*Bundle, error)
+	ExpandBundle(ctx context.Context, in *ExpandBundleRequest, opts ...grpc.CallOption) (*ExpandBundleResponse, error)
+	SetProductRelation(ctx context.Context, in *SetProductRelationRequest, opts ...grpc.CallOption) (*ProductRelation, error)
+	GetProductRelations(ctx context.Context, in *GetProductRelationsRequest, opts ...grpc.CallOption) (*GetProductRelationsResponse, error)
+	DeleteProductRelation(ctx context.Context, in *ProductRelationPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	CloneProduct(ctx context.Context, in *CloneProductRequest, opts ...grpc.CallOption) (*Product, error)
+	FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error)
+	MergeProducts(ctx context.Context, in *MergeProductsRequest, opts ...grpc.CallOption) (*Product, error)
 }
 
 type productServiceClient struct {
@@ -89,6 +121,294 @@ func (c *productServiceClient) Delete(ctx context.Context, in *ProductPK, opts .
 	return out, nil
 }
 
+func (c *productServiceClient) ListProductHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error) {
+	out := new(ListHistoryResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/ListProductHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*ScheduledPriceChange, error) {
+	out := new(ScheduledPriceChange)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/SchedulePriceChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetByBarcode(ctx context.Context, in *GetByBarcodeRequest, opts ...grpc.CallOption) (*Product, error) {
+	out := new(Product)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetByBarcode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPriceOverride(ctx context.Context, in *SetPriceOverrideRequest, opts ...grpc.CallOption) (*PriceOverride, error) {
+	out := new(PriceOverride)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/SetPriceOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceOverrides(ctx context.Context, in *GetPriceOverridesRequest, opts ...grpc.CallOption) (*GetPriceOverridesResponse, error) {
+	out := new(GetPriceOverridesResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetPriceOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeletePriceOverride(ctx context.Context, in *PriceOverridePK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/DeletePriceOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProviderCost(ctx context.Context, in *SetProviderCostRequest, opts ...grpc.CallOption) (*ProviderCost, error) {
+	out := new(ProviderCost)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/SetProviderCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProviderCosts(ctx context.Context, in *GetProviderCostsRequest, opts ...grpc.CallOption) (*GetProviderCostsResponse, error) {
+	out := new(GetProviderCostsResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetProviderCosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RepriceByMarkup(ctx context.Context, in *RepriceByMarkupRequest, opts ...grpc.CallOption) (*RepriceByMarkupResponse, error) {
+	out := new(RepriceByMarkupResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/RepriceByMarkup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ValidateMarkingCode(ctx context.Context, in *ValidateMarkingCodeRequest, opts ...grpc.CallOption) (*ValidateMarkingCodeResponse, error) {
+	out := new(ValidateMarkingCodeResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/ValidateMarkingCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetSaleRestriction(ctx context.Context, in *SetSaleRestrictionRequest, opts ...grpc.CallOption) (*SaleRestriction, error) {
+	out := new(SaleRestriction)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/SetSaleRestriction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetSaleRestrictions(ctx context.Context, in *GetSaleRestrictionsRequest, opts ...grpc.CallOption) (*GetSaleRestrictionsResponse, error) {
+	out := new(GetSaleRestrictionsResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetSaleRestrictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteSaleRestriction(ctx context.Context, in *SaleRestrictionPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/DeleteSaleRestriction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRate, error) {
+	out := new(ExchangeRate)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/SetExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error) {
+	out := new(GetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPackaging(ctx context.Context, in *SetPackagingRequest, opts ...grpc.CallOption) (*Packaging, error) {
+	out := new(Packaging)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/SetPackaging", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPackagings(ctx context.Context, in *GetPackagingsRequest, opts ...grpc.CallOption) (*GetPackagingsResponse, error) {
+	out := new(GetPackagingsResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetPackagings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeletePackaging(ctx context.Context, in *PackagingPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/DeletePackaging", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetQuantityRule(ctx context.Context, in *ProductPK, opts ...grpc.CallOption) (*QuantityRule, error) {
+	out := new(QuantityRule)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetQuantityRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ConvertQuantity(ctx context.Context, in *ConvertQuantityRequest, opts ...grpc.CallOption) (*ConvertQuantityResponse, error) {
+	out := new(ConvertQuantityResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/ConvertQuantity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) TagProducts(ctx context.Context, in *TagProductsRequest, opts ...grpc.CallOption) (*TagProductsResponse, error) {
+	out := new(TagProductsResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/TagProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UntagProducts(ctx context.Context, in *TagProductsRequest, opts ...grpc.CallOption) (*TagProductsResponse, error) {
+	out := new(TagProductsResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/UntagProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetBundle(ctx context.Context, in *SetBundleRequest, opts ...grpc.CallOption) (*Bundle, error) {
+	out := new(Bundle)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/SetBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetBundle(ctx context.Context, in *ProductPK, opts ...grpc.CallOption) (*Bundle, error) {
+	out := new(Bundle)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ExpandBundle(ctx context.Context, in *ExpandBundleRequest, opts ...grpc.CallOption) (*ExpandBundleResponse, error) {
+	out := new(ExpandBundleResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/ExpandBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductRelation(ctx context.Context, in *SetProductRelationRequest, opts ...grpc.CallOption) (*ProductRelation, error) {
+	out := new(ProductRelation)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/SetProductRelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductRelations(ctx context.Context, in *GetProductRelationsRequest, opts ...grpc.CallOption) (*GetProductRelationsResponse, error) {
+	out := new(GetProductRelationsResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/GetProductRelations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductRelation(ctx context.Context, in *ProductRelationPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/DeleteProductRelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CloneProduct(ctx context.Context, in *CloneProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	out := new(Product)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/CloneProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error) {
+	out := new(FindDuplicatesResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/FindDuplicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) MergeProducts(ctx context.Context, in *MergeProductsRequest, opts ...grpc.CallOption) (*Product, error) {
+	out := new(Product)
+	err := c.cc.Invoke(ctx, "/product_service.ProductService/MergeProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility
@@ -99,6 +419,38 @@ type ProductServiceServer interface {
 	Update(context.Context, *UpdateProduct) (*Product, error)
 	UpdatePatch(context.Context, *UpdatePatchProduct) (*Product, error)
 	Delete(context.Context, *ProductPK) (*empty.Empty, error)
+	ListProductHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*ScheduledPriceChange, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	GetByBarcode(context.Context, *GetByBarcodeRequest) (*Product, error)
+	SetPriceOverride(context.Context, *SetPriceOverrideRequest) (*PriceOverride, error)
+	GetPriceOverrides(context.Context, *GetPriceOverridesRequest) (*GetPriceOverridesResponse, error)
+	DeletePriceOverride(context.Context, *PriceOverridePK) (*empty.Empty, error)
+	SetProviderCost(context.Context, *SetProviderCostRequest) (*ProviderCost, error)
+	GetProviderCosts(context.Context, *GetProviderCostsRequest) (*GetProviderCostsResponse, error)
+	RepriceByMarkup(context.Context, *RepriceByMarkupRequest) (*RepriceByMarkupResponse, error)
+	ValidateMarkingCode(context.Context, *ValidateMarkingCodeRequest) (*ValidateMarkingCodeResponse, error)
+	SetSaleRestriction(context.Context, *SetSaleRestrictionRequest) (*SaleRestriction, error)
+	GetSaleRestrictions(context.Context, *GetSaleRestrictionsRequest) (*GetSaleRestrictionsResponse, error)
+	DeleteSaleRestriction(context.Context, *SaleRestrictionPK) (*empty.Empty, error)
+	SetExchangeRate(context.Context, *SetExchangeRateRequest) (*ExchangeRate, error)
+	GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error)
+	SetPackaging(context.Context, *SetPackagingRequest) (*Packaging, error)
+	GetPackagings(context.Context, *GetPackagingsRequest) (*GetPackagingsResponse, error)
+	DeletePackaging(context.Context, *PackagingPK) (*empty.Empty, error)
+	GetQuantityRule(context.Context, *ProductPK) (*QuantityRule, error)
+	ConvertQuantity(context.Context, *ConvertQuantityRequest) (*ConvertQuantityResponse, error)
+	TagProducts(context.Context, *TagProductsRequest) (*TagProductsResponse, error)
+	UntagProducts(context.Context, *TagProductsRequest) (*TagProductsResponse, error)
+	SetBundle(context.Context, *SetBundleRequest) (*Bundle, error)
+	GetBundle(context.Context, *ProductPK) (*Bundle, error)
+	ExpandBundle(context.Context, *ExpandBundleRequest) (*ExpandBundleResponse, error)
+	SetProductRelation(context.Context, *SetProductRelationRequest) (*ProductRelation, error)
+	GetProductRelations(context.Context, *GetProductRelationsRequest) (*GetProductRelationsResponse, error)
+	DeleteProductRelation(context.Context, *ProductRelationPK) (*empty.Empty, error)
+	CloneProduct(context.Context, *CloneProductRequest) (*Product, error)
+	FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error)
+	MergeProducts(context.Context, *MergeProductsRequest) (*Product, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -124,6 +476,102 @@ func (UnimplementedProductServiceServer) UpdatePatch(context.Context, *UpdatePat
 func (UnimplementedProductServiceServer) Delete(context.Context, *ProductPK) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedProductServiceServer) ListProductHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductHistory not implemented")
+}
+func (UnimplementedProductServiceServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*ScheduledPriceChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) GetByBarcode(context.Context, *GetByBarcodeRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByBarcode not implemented")
+}
+func (UnimplementedProductServiceServer) SetPriceOverride(context.Context, *SetPriceOverrideRequest) (*PriceOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceOverride not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceOverrides(context.Context, *GetPriceOverridesRequest) (*GetPriceOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceOverrides not implemented")
+}
+func (UnimplementedProductServiceServer) DeletePriceOverride(context.Context, *PriceOverridePK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceOverride not implemented")
+}
+func (UnimplementedProductServiceServer) SetProviderCost(context.Context, *SetProviderCostRequest) (*ProviderCost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderCost not implemented")
+}
+func (UnimplementedProductServiceServer) GetProviderCosts(context.Context, *GetProviderCostsRequest) (*GetProviderCostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderCosts not implemented")
+}
+func (UnimplementedProductServiceServer) RepriceByMarkup(context.Context, *RepriceByMarkupRequest) (*RepriceByMarkupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepriceByMarkup not implemented")
+}
+func (UnimplementedProductServiceServer) ValidateMarkingCode(context.Context, *ValidateMarkingCodeRequest) (*ValidateMarkingCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateMarkingCode not implemented")
+}
+func (UnimplementedProductServiceServer) SetSaleRestriction(context.Context, *SetSaleRestrictionRequest) (*SaleRestriction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSaleRestriction not implemented")
+}
+func (UnimplementedProductServiceServer) GetSaleRestrictions(context.Context, *GetSaleRestrictionsRequest) (*GetSaleRestrictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSaleRestrictions not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteSaleRestriction(context.Context, *SaleRestrictionPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSaleRestriction not implemented")
+}
+func (UnimplementedProductServiceServer) SetExchangeRate(context.Context, *SetExchangeRateRequest) (*ExchangeRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedProductServiceServer) GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
+func (UnimplementedProductServiceServer) SetPackaging(context.Context, *SetPackagingRequest) (*Packaging, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPackaging not implemented")
+}
+func (UnimplementedProductServiceServer) GetPackagings(context.Context, *GetPackagingsRequest) (*GetPackagingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPackagings not implemented")
+}
+func (UnimplementedProductServiceServer) DeletePackaging(context.Context, *PackagingPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePackaging not implemented")
+}
+func (UnimplementedProductServiceServer) GetQuantityRule(context.Context, *ProductPK) (*QuantityRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuantityRule not implemented")
+}
+func (UnimplementedProductServiceServer) ConvertQuantity(context.Context, *ConvertQuantityRequest) (*ConvertQuantityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertQuantity not implemented")
+}
+func (UnimplementedProductServiceServer) TagProducts(context.Context, *TagProductsRequest) (*TagProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagProducts not implemented")
+}
+func (UnimplementedProductServiceServer) UntagProducts(context.Context, *TagProductsRequest) (*TagProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntagProducts not implemented")
+}
+func (UnimplementedProductServiceServer) SetBundle(context.Context, *SetBundleRequest) (*Bundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBundle not implemented")
+}
+func (UnimplementedProductServiceServer) GetBundle(context.Context, *ProductPK) (*Bundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundle not implemented")
+}
+func (UnimplementedProductServiceServer) ExpandBundle(context.Context, *ExpandBundleRequest) (*ExpandBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpandBundle not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductRelation(context.Context, *SetProductRelationRequest) (*ProductRelation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductRelation not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductRelations(context.Context, *GetProductRelationsRequest) (*GetProductRelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductRelations not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductRelation(context.Context, *ProductRelationPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductRelation not implemented")
+}
+func (UnimplementedProductServiceServer) CloneProduct(context.Context, *CloneProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneProduct not implemented")
+}
+func (UnimplementedProductServiceServer) FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (UnimplementedProductServiceServer) MergeProducts(context.Context, *MergeProductsRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeProducts not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 
 // UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -245,37 +693,741 @@ func _ProductService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ProductService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "product_service.ProductService",
-	HandlerType: (*ProductServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _ProductService_Create_Handler,
-		},
-		{
-			MethodName: "GetByID",
-			Handler:    _ProductService_GetByID_Handler,
-		},
-		{
-			MethodName: "GetList",
-			Handler:    _ProductService_GetList_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _ProductService_Update_Handler,
-		},
-		{
-			MethodName: "UpdatePatch",
+func _ProductService_ListProductHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/ListProductHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductHistory(ctx, req.(*ListHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/SchedulePriceChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetByBarcode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetByBarcode(ctx, req.(*GetByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPriceOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPriceOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/SetPriceOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPriceOverride(ctx, req.(*SetPriceOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPriceOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetPriceOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceOverrides(ctx, req.(*GetPriceOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeletePriceOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceOverridePK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeletePriceOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/DeletePriceOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeletePriceOverride(ctx, req.(*PriceOverridePK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProviderCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProviderCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProviderCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/SetProviderCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProviderCost(ctx, req.(*SetProviderCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProviderCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProviderCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetProviderCosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProviderCosts(ctx, req.(*GetProviderCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RepriceByMarkup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepriceByMarkupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RepriceByMarkup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/RepriceByMarkup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RepriceByMarkup(ctx, req.(*RepriceByMarkupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ValidateMarkingCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateMarkingCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ValidateMarkingCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/ValidateMarkingCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ValidateMarkingCode(ctx, req.(*ValidateMarkingCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetSaleRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSaleRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetSaleRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/SetSaleRestriction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetSaleRestriction(ctx, req.(*SetSaleRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetSaleRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSaleRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetSaleRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetSaleRestrictions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetSaleRestrictions(ctx, req.(*GetSaleRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteSaleRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaleRestrictionPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteSaleRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/DeleteSaleRestriction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteSaleRestriction(ctx, req.(*SaleRestrictionPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/SetExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetExchangeRate(ctx, req.(*SetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetExchangeRates(ctx, req.(*GetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPackaging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPackagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPackaging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/SetPackaging",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPackaging(ctx, req.(*SetPackagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPackagings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPackagingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPackagings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetPackagings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPackagings(ctx, req.(*GetPackagingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeletePackaging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PackagingPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeletePackaging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/DeletePackaging",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeletePackaging(ctx, req.(*PackagingPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetQuantityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetQuantityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetQuantityRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetQuantityRule(ctx, req.(*ProductPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ConvertQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ConvertQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/ConvertQuantity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ConvertQuantity(ctx, req.(*ConvertQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_TagProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).TagProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/TagProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).TagProducts(ctx, req.(*TagProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UntagProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UntagProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/UntagProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UntagProducts(ctx, req.(*TagProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/SetBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetBundle(ctx, req.(*SetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetBundle(ctx, req.(*ProductPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ExpandBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ExpandBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/ExpandBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ExpandBundle(ctx, req.(*ExpandBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/SetProductRelation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductRelation(ctx, req.(*SetProductRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/GetProductRelations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductRelations(ctx, req.(*GetProductRelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRelationPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/DeleteProductRelation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductRelation(ctx, req.(*ProductRelationPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CloneProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CloneProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/CloneProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CloneProduct(ctx, req.(*CloneProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/FindDuplicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).FindDuplicates(ctx, req.(*FindDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_MergeProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).MergeProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductService/MergeProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).MergeProducts(ctx, req.(*MergeProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product_service.ProductService",
+	HandlerType: (*ProductServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ProductService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _ProductService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _ProductService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ProductService_Update_Handler,
+		},
+		{
+			MethodName: "UpdatePatch",
 			Handler:    _ProductService_UpdatePatch_Handler,
 		},
 		{
 			MethodName: "Delete",
 			Handler:    _ProductService_Delete_Handler,
 		},
+		{
+			MethodName: "ListProductHistory",
+			Handler:    _ProductService_ListProductHistory_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _ProductService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _ProductService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetByBarcode",
+			Handler:    _ProductService_GetByBarcode_Handler,
+		},
+		{
+			MethodName: "SetPriceOverride",
+			Handler:    _ProductService_SetPriceOverride_Handler,
+		},
+		{
+			MethodName: "GetPriceOverrides",
+			Handler:    _ProductService_GetPriceOverrides_Handler,
+		},
+		{
+			MethodName: "DeletePriceOverride",
+			Handler:    _ProductService_DeletePriceOverride_Handler,
+		},
+		{
+			MethodName: "SetProviderCost",
+			Handler:    _ProductService_SetProviderCost_Handler,
+		},
+		{
+			MethodName: "GetProviderCosts",
+			Handler:    _ProductService_GetProviderCosts_Handler,
+		},
+		{
+			MethodName: "RepriceByMarkup",
+			Handler:    _ProductService_RepriceByMarkup_Handler,
+		},
+		{
+			MethodName: "ValidateMarkingCode",
+			Handler:    _ProductService_ValidateMarkingCode_Handler,
+		},
+		{
+			MethodName: "SetSaleRestriction",
+			Handler:    _ProductService_SetSaleRestriction_Handler,
+		},
+		{
+			MethodName: "GetSaleRestrictions",
+			Handler:    _ProductService_GetSaleRestrictions_Handler,
+		},
+		{
+			MethodName: "DeleteSaleRestriction",
+			Handler:    _ProductService_DeleteSaleRestriction_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _ProductService_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _ProductService_GetExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetPackaging",
+			Handler:    _ProductService_SetPackaging_Handler,
+		},
+		{
+			MethodName: "GetPackagings",
+			Handler:    _ProductService_GetPackagings_Handler,
+		},
+		{
+			MethodName: "DeletePackaging",
+			Handler:    _ProductService_DeletePackaging_Handler,
+		},
+		{
+			MethodName: "GetQuantityRule",
+			Handler:    _ProductService_GetQuantityRule_Handler,
+		},
+		{
+			MethodName: "ConvertQuantity",
+			Handler:    _ProductService_ConvertQuantity_Handler,
+		},
+		{
+			MethodName: "TagProducts",
+			Handler:    _ProductService_TagProducts_Handler,
+		},
+		{
+			MethodName: "UntagProducts",
+			Handler:    _ProductService_UntagProducts_Handler,
+		},
+		{
+			MethodName: "SetBundle",
+			Handler:    _ProductService_SetBundle_Handler,
+		},
+		{
+			MethodName: "GetBundle",
+			Handler:    _ProductService_GetBundle_Handler,
+		},
+		{
+			MethodName: "ExpandBundle",
+			Handler:    _ProductService_ExpandBundle_Handler,
+		},
+		{
+			MethodName: "SetProductRelation",
+			Handler:    _ProductService_SetProductRelation_Handler,
+		},
+		{
+			MethodName: "GetProductRelations",
+			Handler:    _ProductService_GetProductRelations_Handler,
+		},
+		{
+			MethodName: "DeleteProductRelation",
+			Handler:    _ProductService_DeleteProductRelation_Handler,
+		},
+		{
+			MethodName: "CloneProduct",
+			Handler:    _ProductService_CloneProduct_Handler,
+		},
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _ProductService_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "MergeProducts",
+			Handler:    _ProductService_MergeProducts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "product_service.proto",
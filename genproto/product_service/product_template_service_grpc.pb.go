@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package product_service
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProductTemplateServiceClient is the client API for ProductTemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProductTemplateServiceClient interface {
+	Create(ctx context.Context, in *CreateProductTemplate, opts ...grpc.CallOption) (*ProductTemplate, error)
+	GetByID(ctx context.Context, in *ProductTemplatePK, opts ...grpc.CallOption) (*ProductTemplate, error)
+	GetList(ctx context.Context, in *GetListProductTemplateRequest, opts ...grpc.CallOption) (*GetListProductTemplateResponse, error)
+	Update(ctx context.Context, in *UpdateProductTemplate, opts ...grpc.CallOption) (*ProductTemplate, error)
+	Delete(ctx context.Context, in *ProductTemplatePK, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type productTemplateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProductTemplateServiceClient(cc grpc.ClientConnInterface) ProductTemplateServiceClient {
+	return &productTemplateServiceClient{cc}
+}
+
+func (c *productTemplateServiceClient) Create(ctx context.Context, in *CreateProductTemplate, opts ...grpc.CallOption) (*ProductTemplate, error) {
+	out := new(ProductTemplate)
+	err := c.cc.Invoke(ctx, "/product_service.ProductTemplateService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productTemplateServiceClient) GetByID(ctx context.Context, in *ProductTemplatePK, opts ...grpc.CallOption) (*ProductTemplate, error) {
+	out := new(ProductTemplate)
+	err := c.cc.Invoke(ctx, "/product_service.ProductTemplateService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productTemplateServiceClient) GetList(ctx context.Context, in *GetListProductTemplateRequest, opts ...grpc.CallOption) (*GetListProductTemplateResponse, error) {
+	out := new(GetListProductTemplateResponse)
+	err := c.cc.Invoke(ctx, "/product_service.ProductTemplateService/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productTemplateServiceClient) Update(ctx context.Context, in *UpdateProductTemplate, opts ...grpc.CallOption) (*ProductTemplate, error) {
+	out := new(ProductTemplate)
+	err := c.cc.Invoke(ctx, "/product_service.ProductTemplateService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productTemplateServiceClient) Delete(ctx context.Context, in *ProductTemplatePK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.ProductTemplateService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductTemplateServiceServer is the server API for ProductTemplateService service.
+// All implementations must embed UnimplementedProductTemplateServiceServer
+// for forward compatibility
+type ProductTemplateServiceServer interface {
+	Create(context.Context, *CreateProductTemplate) (*ProductTemplate, error)
+	GetByID(context.Context, *ProductTemplatePK) (*ProductTemplate, error)
+	GetList(context.Context, *GetListProductTemplateRequest) (*GetListProductTemplateResponse, error)
+	Update(context.Context, *UpdateProductTemplate) (*ProductTemplate, error)
+	Delete(context.Context, *ProductTemplatePK) (*empty.Empty, error)
+	mustEmbedUnimplementedProductTemplateServiceServer()
+}
+
+// UnimplementedProductTemplateServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProductTemplateServiceServer struct {
+}
+
+func (UnimplementedProductTemplateServiceServer) Create(context.Context, *CreateProductTemplate) (*ProductTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedProductTemplateServiceServer) GetByID(context.Context, *ProductTemplatePK) (*ProductTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedProductTemplateServiceServer) GetList(context.Context, *GetListProductTemplateRequest) (*GetListProductTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedProductTemplateServiceServer) Update(context.Context, *UpdateProductTemplate) (*ProductTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedProductTemplateServiceServer) Delete(context.Context, *ProductTemplatePK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedProductTemplateServiceServer) mustEmbedUnimplementedProductTemplateServiceServer() {
+}
+
+// UnsafeProductTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductTemplateServiceServer will
+// result in compilation errors.
+type UnsafeProductTemplateServiceServer interface {
+	mustEmbedUnimplementedProductTemplateServiceServer()
+}
+
+func RegisterProductTemplateServiceServer(s grpc.ServiceRegistrar, srv ProductTemplateServiceServer) {
+	s.RegisterService(&ProductTemplateService_ServiceDesc, srv)
+}
+
+func _ProductTemplateService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductTemplateServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductTemplateService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductTemplateServiceServer).Create(ctx, req.(*CreateProductTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductTemplateService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductTemplatePK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductTemplateServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductTemplateService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductTemplateServiceServer).GetByID(ctx, req.(*ProductTemplatePK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductTemplateService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListProductTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductTemplateServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductTemplateService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductTemplateServiceServer).GetList(ctx, req.(*GetListProductTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductTemplateService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductTemplateServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductTemplateService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductTemplateServiceServer).Update(ctx, req.(*UpdateProductTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductTemplateService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductTemplatePK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductTemplateServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.ProductTemplateService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductTemplateServiceServer).Delete(ctx, req.(*ProductTemplatePK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProductTemplateService_ServiceDesc is the grpc.ServiceDesc for ProductTemplateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductTemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product_service.ProductTemplateService",
+	HandlerType: (*ProductTemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ProductTemplateService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _ProductTemplateService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _ProductTemplateService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ProductTemplateService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ProductTemplateService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "product_template_service.proto",
+}
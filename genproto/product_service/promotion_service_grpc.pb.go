@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package product_service
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PromotionServiceClient is the client API for PromotionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PromotionServiceClient interface {
+	Create(ctx context.Context, in *CreatePromotion, opts ...grpc.CallOption) (*Promotion, error)
+	GetByID(ctx context.Context, in *PromotionPK, opts ...grpc.CallOption) (*Promotion, error)
+	GetList(ctx context.Context, in *GetListPromotionRequest, opts ...grpc.CallOption) (*GetListPromotionResponse, error)
+	Update(ctx context.Context, in *UpdatePromotion, opts ...grpc.CallOption) (*Promotion, error)
+	Delete(ctx context.Context, in *PromotionPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	EvaluateBasket(ctx context.Context, in *EvaluateBasketRequest, opts ...grpc.CallOption) (*EvaluateBasketResponse, error)
+}
+
+type promotionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromotionServiceClient(cc grpc.ClientConnInterface) PromotionServiceClient {
+	return &promotionServiceClient{cc}
+}
+
+func (c *promotionServiceClient) Create(ctx context.Context, in *CreatePromotion, opts ...grpc.CallOption) (*Promotion, error) {
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, "/product_service.PromotionService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) GetByID(ctx context.Context, in *PromotionPK, opts ...grpc.CallOption) (*Promotion, error) {
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, "/product_service.PromotionService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) GetList(ctx context.Context, in *GetListPromotionRequest, opts ...grpc.CallOption) (*GetListPromotionResponse, error) {
+	out := new(GetListPromotionResponse)
+	err := c.cc.Invoke(ctx, "/product_service.PromotionService/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) Update(ctx context.Context, in *UpdatePromotion, opts ...grpc.CallOption) (*Promotion, error) {
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, "/product_service.PromotionService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) Delete(ctx context.Context, in *PromotionPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.PromotionService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) EvaluateBasket(ctx context.Context, in *EvaluateBasketRequest, opts ...grpc.CallOption) (*EvaluateBasketResponse, error) {
+	out := new(EvaluateBasketResponse)
+	err := c.cc.Invoke(ctx, "/product_service.PromotionService/EvaluateBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromotionServiceServer is the server API for PromotionService service.
+// All implementations must embed UnimplementedPromotionServiceServer
+// for forward compatibility
+type PromotionServiceServer interface {
+	Create(context.Context, *CreatePromotion) (*Promotion, error)
+	GetByID(context.Context, *PromotionPK) (*Promotion, error)
+	GetList(context.Context, *GetListPromotionRequest) (*GetListPromotionResponse, error)
+	Update(context.Context, *UpdatePromotion) (*Promotion, error)
+	Delete(context.Context, *PromotionPK) (*empty.Empty, error)
+	EvaluateBasket(context.Context, *EvaluateBasketRequest) (*EvaluateBasketResponse, error)
+	mustEmbedUnimplementedPromotionServiceServer()
+}
+
+// UnimplementedPromotionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPromotionServiceServer struct {
+}
+
+func (UnimplementedPromotionServiceServer) Create(context.Context, *CreatePromotion) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedPromotionServiceServer) GetByID(context.Context, *PromotionPK) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedPromotionServiceServer) GetList(context.Context, *GetListPromotionRequest) (*GetListPromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedPromotionServiceServer) Update(context.Context, *UpdatePromotion) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPromotionServiceServer) Delete(context.Context, *PromotionPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPromotionServiceServer) EvaluateBasket(context.Context, *EvaluateBasketRequest) (*EvaluateBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateBasket not implemented")
+}
+func (UnimplementedPromotionServiceServer) mustEmbedUnimplementedPromotionServiceServer() {}
+
+// UnsafePromotionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromotionServiceServer will
+// result in compilation errors.
+type UnsafePromotionServiceServer interface {
+	mustEmbedUnimplementedPromotionServiceServer()
+}
+
+func RegisterPromotionServiceServer(s grpc.ServiceRegistrar, srv PromotionServiceServer) {
+	s.RegisterService(&PromotionService_ServiceDesc, srv)
+}
+
+func _PromotionService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PromotionService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).Create(ctx, req.(*CreatePromotion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PromotionService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).GetByID(ctx, req.(*PromotionPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListPromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PromotionService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).GetList(ctx, req.(*GetListPromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromotion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PromotionService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).Update(ctx, req.(*UpdatePromotion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PromotionService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).Delete(ctx, req.(*PromotionPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_EvaluateBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).EvaluateBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.PromotionService/EvaluateBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).EvaluateBasket(ctx, req.(*EvaluateBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromotionService_ServiceDesc is the grpc.ServiceDesc for PromotionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromotionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product_service.PromotionService",
+	HandlerType: (*PromotionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _PromotionService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _PromotionService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _PromotionService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PromotionService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PromotionService_Delete_Handler,
+		},
+		{
+			MethodName: "EvaluateBasket",
+			Handler:    _PromotionService_EvaluateBasket_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "promotion_service.proto",
+}
@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package product_service
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TaxRateServiceClient is the client API for TaxRateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaxRateServiceClient interface {
+	Create(ctx context.Context, in *CreateTaxRate, opts ...grpc.CallOption) (*TaxRate, error)
+	GetByID(ctx context.Context, in *TaxRatePK, opts ...grpc.CallOption) (*TaxRate, error)
+	GetList(ctx context.Context, in *GetListTaxRateRequest, opts ...grpc.CallOption) (*GetListTaxRateResponse, error)
+	Update(ctx context.Context, in *UpdateTaxRate, opts ...grpc.CallOption) (*TaxRate, error)
+	Delete(ctx context.Context, in *TaxRatePK, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type taxRateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaxRateServiceClient(cc grpc.ClientConnInterface) TaxRateServiceClient {
+	return &taxRateServiceClient{cc}
+}
+
+func (c *taxRateServiceClient) Create(ctx context.Context, in *CreateTaxRate, opts ...grpc.CallOption) (*TaxRate, error) {
+	out := new(TaxRate)
+	err := c.cc.Invoke(ctx, "/product_service.TaxRateService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxRateServiceClient) GetByID(ctx context.Context, in *TaxRatePK, opts ...grpc.CallOption) (*TaxRate, error) {
+	out := new(TaxRate)
+	err := c.cc.Invoke(ctx, "/product_service.TaxRateService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxRateServiceClient) GetList(ctx context.Context, in *GetListTaxRateRequest, opts ...grpc.CallOption) (*GetListTaxRateResponse, error) {
+	out := new(GetListTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/product_service.TaxRateService/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxRateServiceClient) Update(ctx context.Context, in *UpdateTaxRate, opts ...grpc.CallOption) (*TaxRate, error) {
+	out := new(TaxRate)
+	err := c.cc.Invoke(ctx, "/product_service.TaxRateService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxRateServiceClient) Delete(ctx context.Context, in *TaxRatePK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.TaxRateService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaxRateServiceServer is the server API for TaxRateService service.
+// All implementations must embed UnimplementedTaxRateServiceServer
+// for forward compatibility
+type TaxRateServiceServer interface {
+	Create(context.Context, *CreateTaxRate) (*TaxRate, error)
+	GetByID(context.Context, *TaxRatePK) (*TaxRate, error)
+	GetList(context.Context, *GetListTaxRateRequest) (*GetListTaxRateResponse, error)
+	Update(context.Context, *UpdateTaxRate) (*TaxRate, error)
+	Delete(context.Context, *TaxRatePK) (*empty.Empty, error)
+	mustEmbedUnimplementedTaxRateServiceServer()
+}
+
+// UnimplementedTaxRateServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTaxRateServiceServer struct {
+}
+
+func (UnimplementedTaxRateServiceServer) Create(context.Context, *CreateTaxRate) (*TaxRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTaxRateServiceServer) GetByID(context.Context, *TaxRatePK) (*TaxRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedTaxRateServiceServer) GetList(context.Context, *GetListTaxRateRequest) (*GetListTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedTaxRateServiceServer) Update(context.Context, *UpdateTaxRate) (*TaxRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaxRateServiceServer) Delete(context.Context, *TaxRatePK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTaxRateServiceServer) mustEmbedUnimplementedTaxRateServiceServer() {}
+
+// UnsafeTaxRateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaxRateServiceServer will
+// result in compilation errors.
+type UnsafeTaxRateServiceServer interface {
+	mustEmbedUnimplementedTaxRateServiceServer()
+}
+
+func RegisterTaxRateServiceServer(s grpc.ServiceRegistrar, srv TaxRateServiceServer) {
+	s.RegisterService(&TaxRateService_ServiceDesc, srv)
+}
+
+func _TaxRateService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaxRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxRateServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.TaxRateService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxRateServiceServer).Create(ctx, req.(*CreateTaxRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxRateService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaxRatePK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxRateServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.TaxRateService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxRateServiceServer).GetByID(ctx, req.(*TaxRatePK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxRateService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxRateServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.TaxRateService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxRateServiceServer).GetList(ctx, req.(*GetListTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxRateService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaxRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxRateServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.TaxRateService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxRateServiceServer).Update(ctx, req.(*UpdateTaxRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxRateService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaxRatePK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxRateServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.TaxRateService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxRateServiceServer).Delete(ctx, req.(*TaxRatePK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaxRateService_ServiceDesc is the grpc.ServiceDesc for TaxRateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaxRateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product_service.TaxRateService",
+	HandlerType: (*TaxRateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TaxRateService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _TaxRateService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _TaxRateService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TaxRateService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TaxRateService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tax_rate_service.proto",
+}
@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package product_service
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// UnitServiceClient is the client API for UnitService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UnitServiceClient interface {
+	Create(ctx context.Context, in *CreateUnit, opts ...grpc.CallOption) (*Unit, error)
+	GetByID(ctx context.Context, in *UnitPK, opts ...grpc.CallOption) (*Unit, error)
+	GetList(ctx context.Context, in *GetListUnitRequest, opts ...grpc.CallOption) (*GetListUnitResponse, error)
+	Update(ctx context.Context, in *UpdateUnit, opts ...grpc.CallOption) (*Unit, error)
+	Delete(ctx context.Context, in *UnitPK, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type unitServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUnitServiceClient(cc grpc.ClientConnInterface) UnitServiceClient {
+	return &unitServiceClient{cc}
+}
+
+func (c *unitServiceClient) Create(ctx context.Context, in *CreateUnit, opts ...grpc.CallOption) (*Unit, error) {
+	out := new(Unit)
+	err := c.cc.Invoke(ctx, "/product_service.UnitService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitServiceClient) GetByID(ctx context.Context, in *UnitPK, opts ...grpc.CallOption) (*Unit, error) {
+	out := new(Unit)
+	err := c.cc.Invoke(ctx, "/product_service.UnitService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitServiceClient) GetList(ctx context.Context, in *GetListUnitRequest, opts ...grpc.CallOption) (*GetListUnitResponse, error) {
+	out := new(GetListUnitResponse)
+	err := c.cc.Invoke(ctx, "/product_service.UnitService/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitServiceClient) Update(ctx context.Context, in *UpdateUnit, opts ...grpc.CallOption) (*Unit, error) {
+	out := new(Unit)
+	err := c.cc.Invoke(ctx, "/product_service.UnitService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitServiceClient) Delete(ctx context.Context, in *UnitPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/product_service.UnitService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UnitServiceServer is the server API for UnitService service.
+// All implementations must embed UnimplementedUnitServiceServer
+// for forward compatibility
+type UnitServiceServer interface {
+	Create(context.Context, *CreateUnit) (*Unit, error)
+	GetByID(context.Context, *UnitPK) (*Unit, error)
+	GetList(context.Context, *GetListUnitRequest) (*GetListUnitResponse, error)
+	Update(context.Context, *UpdateUnit) (*Unit, error)
+	Delete(context.Context, *UnitPK) (*empty.Empty, error)
+	mustEmbedUnimplementedUnitServiceServer()
+}
+
+// UnimplementedUnitServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUnitServiceServer struct {
+}
+
+func (UnimplementedUnitServiceServer) Create(context.Context, *CreateUnit) (*Unit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedUnitServiceServer) GetByID(context.Context, *UnitPK) (*Unit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedUnitServiceServer) GetList(context.Context, *GetListUnitRequest) (*GetListUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedUnitServiceServer) Update(context.Context, *UpdateUnit) (*Unit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedUnitServiceServer) Delete(context.Context, *UnitPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedUnitServiceServer) mustEmbedUnimplementedUnitServiceServer() {}
+
+// UnsafeUnitServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UnitServiceServer will
+// result in compilation errors.
+type UnsafeUnitServiceServer interface {
+	mustEmbedUnimplementedUnitServiceServer()
+}
+
+func RegisterUnitServiceServer(s grpc.ServiceRegistrar, srv UnitServiceServer) {
+	s.RegisterService(&UnitService_ServiceDesc, srv)
+}
+
+func _UnitService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUnit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.UnitService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitServiceServer).Create(ctx, req.(*CreateUnit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnitService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnitPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.UnitService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitServiceServer).GetByID(ctx, req.(*UnitPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnitService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.UnitService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitServiceServer).GetList(ctx, req.(*GetListUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnitService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUnit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.UnitService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitServiceServer).Update(ctx, req.(*UpdateUnit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnitService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnitPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/product_service.UnitService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitServiceServer).Delete(ctx, req.(*UnitPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UnitService_ServiceDesc is the grpc.ServiceDesc for UnitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UnitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product_service.UnitService",
+	HandlerType: (*UnitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _UnitService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _UnitService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _UnitService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _UnitService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _UnitService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "unit_service.proto",
+}
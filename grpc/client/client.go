@@ -1,12 +1,50 @@
 package client
 
-import "product_service/config"
+import (
+	"product_service/config"
+	"product_service/genproto/organization_service"
 
-type ServiceManagerI interface{}
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
 
-type grpcClients struct{}
+type ServiceManagerI interface {
+	FilialService() organization_service.FilialServiceClient
+	MagazinService() organization_service.MagazinServiceClient
+	ProviderService() organization_service.ProviderServiceClient
+}
+
+type grpcClients struct {
+	filialService   organization_service.FilialServiceClient
+	magazinService  organization_service.MagazinServiceClient
+	providerService organization_service.ProviderServiceClient
+}
 
 func NewGrpcClients(cfg config.Config) (ServiceManagerI, error) {
 
-	return &grpcClients{}, nil
+	connOrganizationService, err := grpc.Dial(
+		cfg.OrganizationServiceHost+cfg.OrganizationServicePort,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcClients{
+		filialService:   organization_service.NewFilialServiceClient(connOrganizationService),
+		magazinService:  organization_service.NewMagazinServiceClient(connOrganizationService),
+		providerService: organization_service.NewProviderServiceClient(connOrganizationService),
+	}, nil
+}
+
+func (g *grpcClients) FilialService() organization_service.FilialServiceClient {
+	return g.filialService
+}
+
+func (g *grpcClients) MagazinService() organization_service.MagazinServiceClient {
+	return g.magazinService
+}
+
+func (g *grpcClients) ProviderService() organization_service.ProviderServiceClient {
+	return g.providerService
 }
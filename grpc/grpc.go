@@ -14,10 +14,22 @@ import (
 
 func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvc client.ServiceManagerI) (grpcServer *grpc.Server) {
 
-	grpcServer = grpc.NewServer()
+	grpcServer = grpc.NewServer(grpc.UnaryInterceptor(authInterceptor(cfg, log)))
 
 	product_service.RegisterProductServiceServer(grpcServer, service.NewProductService(cfg, log, strg, srvc))
 	product_service.RegisterCategoryServiceServer(grpcServer, service.NewCategoryService(cfg, log, strg, srvc))
+	product_service.RegisterPromotionServiceServer(grpcServer, service.NewPromotionService(cfg, log, strg, srvc))
+	product_service.RegisterPriceListServiceServer(grpcServer, service.NewPriceListService(cfg, log, strg, srvc))
+	product_service.RegisterTaxRateServiceServer(grpcServer, service.NewTaxRateService(cfg, log, strg, srvc))
+	product_service.RegisterUnitServiceServer(grpcServer, service.NewUnitService(cfg, log, strg, srvc))
+	product_service.RegisterBrandServiceServer(grpcServer, service.NewBrandService(cfg, log, strg, srvc))
+	product_service.RegisterManufacturerServiceServer(grpcServer, service.NewManufacturerService(cfg, log, strg, srvc))
+	product_service.RegisterTagServiceServer(grpcServer, service.NewTagService(cfg, log, strg, srvc))
+	product_service.RegisterCollectionServiceServer(grpcServer, service.NewCollectionService(cfg, log, strg, srvc))
+	product_service.RegisterProductTemplateServiceServer(grpcServer, service.NewProductTemplateService(cfg, log, strg, srvc))
+	product_service.RegisterInventoryServiceServer(grpcServer, service.NewInventoryService(cfg, log, strg, srvc))
+	product_service.RegisterGoodsReceiptServiceServer(grpcServer, service.NewGoodsReceiptService(cfg, log, strg, srvc))
+	product_service.RegisterPurchaseOrderServiceServer(grpcServer, service.NewPurchaseOrderService(cfg, log, strg, srvc))
 
 	reflection.Register(grpcServer)
 	return
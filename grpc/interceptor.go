@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/pkg/helper"
+	"product_service/pkg/logger"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authInterceptor verifies the bearer access token of the call and puts its claims into the context,
+// calls without a token go on anonymously while a token that does not verify is refused
+func authInterceptor(cfg config.Config, log logger.LoggerI) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		authorization := helper.GetMetadataValue(ctx, helper.AuthorizationMetadataKey)
+		if len(authorization) == 0 {
+			return handler(ctx, req)
+		}
+
+		claims, err := helper.ParseAccessToken(strings.TrimPrefix(authorization, "Bearer "), cfg.AuthSecret)
+		if err != nil {
+			log.Error("!!!AuthInterceptor->ParseAccessToken--->", logger.Error(err), logger.String("method", info.FullMethod))
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(helper.WithClaims(ctx, claims), req)
+	}
+}
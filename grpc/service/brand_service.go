@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/logger"
+	"product_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BrandService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*product_service.UnimplementedBrandServiceServer
+}
+
+func NewBrandService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *BrandService {
+	return &BrandService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *BrandService) Create(ctx context.Context, req *product_service.CreateBrand) (resp *product_service.Brand, err error) {
+
+	i.log.Info("---CreateBrand------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	pKey, err := i.strg.Brand().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreateBrand->Brand->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.Brand().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyBrand->Brand->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *BrandService) GetByID(ctx context.Context, req *product_service.BrandPK) (resp *product_service.Brand, err error) {
+
+	i.log.Info("---GetBrandByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.Brand().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetBrandByID->Brand->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *BrandService) GetList(ctx context.Context, req *product_service.GetListBrandRequest) (resp *product_service.GetListBrandResponse, err error) {
+
+	i.log.Info("---GetBrands------>", logger.Any("req", req))
+
+	resp, err = i.strg.Brand().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetBrands->Brand->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *BrandService) Update(ctx context.Context, req *product_service.UpdateBrand) (resp *product_service.Brand, err error) {
+
+	i.log.Info("---UpdateBrand------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	rowsAffected, err := i.strg.Brand().Update(ctx, req)
+
+	if err != nil {
+		i.log.Error("!!!UpdateBrand--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.Brand().GetByID(ctx, &product_service.BrandPK{Id: req.Id})
+	if err != nil {
+		i.log.Error("!!!GetBrand->Brand->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
+func (i *BrandService) Delete(ctx context.Context, req *product_service.BrandPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteBrand------>", logger.Any("req", req))
+
+	err = i.strg.Brand().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteBrand->Brand->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
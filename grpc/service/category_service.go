@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"product_service/config"
 	"product_service/genproto/product_service"
@@ -11,6 +12,7 @@ import (
 	"product_service/storage"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jackc/pgx/v4"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -81,6 +83,10 @@ func (i *CategoryService) Update(ctx context.Context, req *product_service.Updat
 
 	i.log.Info("---UpdateCategory------>", logger.Any("req", req))
 
+	if req.GetVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, config.ErrVersionRequired)
+	}
+
 	rowsAffected, err := i.strg.Category().Update(ctx, req)
 
 	if err != nil {
@@ -89,7 +95,7 @@ func (i *CategoryService) Update(ctx context.Context, req *product_service.Updat
 	}
 
 	if rowsAffected <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+		return nil, i.staleVersionError(ctx, req.GetId())
 	}
 
 	resp, err = i.strg.Category().GetByID(ctx, &product_service.CategoryPK{Id: req.Id})
@@ -105,9 +111,14 @@ func (i *CategoryService) UpdatePatch(ctx context.Context, req *product_service.
 
 	i.log.Info("---UpdatePatchCategory------>", logger.Any("req", req))
 
+	if req.GetVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, config.ErrVersionRequired)
+	}
+
 	updatePatchModel := models.UpdatePatchRequest{
-		Id:     req.GetId(),
-		Fields: req.GetFields().AsMap(),
+		Id:      req.GetId(),
+		Version: req.GetVersion(),
+		Fields:  req.GetFields().AsMap(),
 	}
 
 	rowsAffected, err := i.strg.Category().UpdatePatch(ctx, &updatePatchModel)
@@ -118,7 +129,7 @@ func (i *CategoryService) UpdatePatch(ctx context.Context, req *product_service.
 	}
 
 	if rowsAffected <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+		return nil, i.staleVersionError(ctx, req.GetId())
 	}
 
 	resp, err = i.strg.Category().GetByID(ctx, &product_service.CategoryPK{Id: req.Id})
@@ -143,3 +154,30 @@ func (i *CategoryService) Delete(ctx context.Context, req *product_service.Categ
 
 	return &empty.Empty{}, nil
 }
+
+func (i *CategoryService) ListCategoryHistory(ctx context.Context, req *product_service.ListHistoryRequest) (resp *product_service.ListHistoryResponse, err error) {
+
+	i.log.Info("---ListCategoryHistory------>", logger.Any("req", req))
+
+	resp, err = i.strg.Audit().GetList(ctx, models.AuditEntityCategory, req)
+	if err != nil {
+		i.log.Error("!!!ListCategoryHistory->Audit->GetList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+// staleVersionError tells a missing category from one changed since the version the caller read
+func (i *CategoryService) staleVersionError(ctx context.Context, id string) error {
+
+	current, err := i.strg.Category().GetByID(ctx, &product_service.CategoryPK{Id: id})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Error(codes.NotFound, "category not found")
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Errorf(codes.Aborted, config.ErrStaleVersion, current.Version)
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/logger"
+	"product_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CollectionService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	products *ProductService
+	*product_service.UnimplementedCollectionServiceServer
+}
+
+func NewCollectionService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *CollectionService {
+	return &CollectionService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+		products: NewProductService(cfg, log, strg, srvs),
+	}
+}
+
+func (i *CollectionService) Create(ctx context.Context, req *product_service.CreateCollection) (resp *product_service.Collection, err error) {
+
+	i.log.Info("---CreateCollection------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	err = validateCollectionRule(req.GetRule())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.checkRuleReferences(ctx, req.GetRule())
+	if err != nil {
+		return nil, err
+	}
+
+	pKey, err := i.strg.Collection().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreateCollection->Collection->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.Collection().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyCollection->Collection->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *CollectionService) GetByID(ctx context.Context, req *product_service.CollectionPK) (resp *product_service.Collection, err error) {
+
+	i.log.Info("---GetCollectionByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.Collection().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetCollectionByID->Collection->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *CollectionService) GetList(ctx context.Context, req *product_service.GetListCollectionRequest) (resp *product_service.GetListCollectionResponse, err error) {
+
+	i.log.Info("---GetCollections------>", logger.Any("req", req))
+
+	resp, err = i.strg.Collection().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetCollections->Collection->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *CollectionService) Update(ctx context.Context, req *product_service.UpdateCollection) (resp *product_service.Collection, err error) {
+
+	i.log.Info("---UpdateCollection------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	err = validateCollectionRule(req.GetRule())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.checkRuleReferences(ctx, req.GetRule())
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := i.strg.Collection().Update(ctx, req)
+
+	if err != nil {
+		i.log.Error("!!!UpdateCollection--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.Collection().GetByID(ctx, &product_service.CollectionPK{Id: req.Id})
+	if err != nil {
+		i.log.Error("!!!GetCollection->Collection->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
+func (i *CollectionService) Delete(ctx context.Context, req *product_service.CollectionPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteCollection------>", logger.Any("req", req))
+
+	err = i.strg.Collection().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteCollection->Collection->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ListCollectionProducts evaluates the stored rule of the collection against the current products
+func (i *CollectionService) ListCollectionProducts(ctx context.Context, req *product_service.ListCollectionProductsRequest) (resp *product_service.GetListProductResponse, err error) {
+
+	i.log.Info("---ListCollectionProducts------>", logger.Any("req", req))
+
+	collection, err := i.strg.Collection().GetByID(ctx, &product_service.CollectionPK{Id: req.GetId()})
+	if err != nil {
+		i.log.Error("!!!ListCollectionProducts->Collection->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	listReq := collectionListRequest(collection.GetRule(), req.GetOffset(), req.GetLimit())
+	listReq.FilialId = req.GetFilialId()
+	listReq.MagazinId = req.GetMagazinId()
+	listReq.Currency = req.GetCurrency()
+
+	return i.products.GetList(ctx, listReq)
+}
+
+// PreviewCollection validates a rule and returns the products it would select without saving it
+func (i *CollectionService) PreviewCollection(ctx context.Context, req *product_service.PreviewCollectionRequest) (resp *product_service.GetListProductResponse, err error) {
+
+	i.log.Info("---PreviewCollection------>", logger.Any("req", req))
+
+	err = validateCollectionRule(req.GetRule())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return i.products.GetList(ctx, collectionListRequest(req.GetRule(), req.GetOffset(), req.GetLimit()))
+}
+
+// checkRuleReferences makes sure the categories, brands, manufacturer and tags of the rule exist
+func (i *CollectionService) checkRuleReferences(ctx context.Context, rule *product_service.CollectionRule) error {
+	for _, id := range rule.GetCategoryIds() {
+		if _, err := i.strg.Category().GetByID(ctx, &product_service.CategoryPK{Id: id}); err != nil {
+			i.log.Error("!!!CheckRuleReferences->Category->Get--->", logger.Error(err))
+			return status.Error(codes.InvalidArgument, "category "+id+" not found")
+		}
+	}
+
+	for _, id := range rule.GetBrandIds() {
+		if _, err := i.strg.Brand().GetByID(ctx, &product_service.BrandPK{Id: id}); err != nil {
+			i.log.Error("!!!CheckRuleReferences->Brand->Get--->", logger.Error(err))
+			return status.Error(codes.InvalidArgument, "brand "+id+" not found")
+		}
+	}
+
+	if len(rule.GetManufacturerId()) > 0 {
+		if _, err := i.strg.Manufacturer().GetByID(ctx, &product_service.ManufacturerPK{Id: rule.GetManufacturerId()}); err != nil {
+			i.log.Error("!!!CheckRuleReferences->Manufacturer->Get--->", logger.Error(err))
+			return status.Error(codes.InvalidArgument, "manufacturer "+rule.GetManufacturerId()+" not found")
+		}
+	}
+
+	for _, id := range rule.GetTagIds() {
+		if _, err := i.strg.Tag().GetByID(ctx, &product_service.TagPK{Id: id}); err != nil {
+			i.log.Error("!!!CheckRuleReferences->Tag->Get--->", logger.Error(err))
+			return status.Error(codes.InvalidArgument, "tag "+id+" not found")
+		}
+	}
+
+	return nil
+}
+
+// collectionListRequest turns a collection rule into a product list request, its categories include their subcategories
+func collectionListRequest(rule *product_service.CollectionRule, offset, limit int64) *product_service.GetListProductRequest {
+	return &product_service.GetListProductRequest{
+		Offset:            offset,
+		Limit:             limit,
+		Search:            rule.GetSearch(),
+		CategoryIds:       rule.GetCategoryIds(),
+		WithSubcategories: true,
+		BrandIds:          rule.GetBrandIds(),
+		ManufacturerId:    rule.GetManufacturerId(),
+		TagIds:            rule.GetTagIds(),
+		TagMatch:          rule.GetTagMatch(),
+		MinPrice:          rule.GetMinPrice(),
+		MaxPrice:          rule.GetMaxPrice(),
+	}
+}
+
+func validateCollectionRule(rule *product_service.CollectionRule) error {
+	if rule == nil {
+		return errors.New("rule is required")
+	}
+
+	if len(rule.GetSearch()) == 0 && len(rule.GetCategoryIds()) == 0 && len(rule.GetBrandIds()) == 0 &&
+		len(rule.GetManufacturerId()) == 0 && len(rule.GetTagIds()) == 0 && rule.GetMinPrice() == 0 && rule.GetMaxPrice() == 0 {
+		return errors.New("rule needs at least one condition")
+	}
+
+	if rule.GetMinPrice() < 0 || rule.GetMaxPrice() < 0 {
+		return errors.New("prices must not be negative")
+	}
+	if rule.GetMaxPrice() > 0 && rule.GetMinPrice() > rule.GetMaxPrice() {
+		return errors.New("min_price must not exceed max_price")
+	}
+
+	switch rule.GetTagMatch() {
+	case "", config.TagMatchAny, config.TagMatchAll:
+	default:
+		return errors.New("tag_match must be any or all")
+	}
+
+	ids := append(append(append([]string{}, rule.GetCategoryIds()...), rule.GetBrandIds()...), rule.GetTagIds()...)
+	if len(rule.GetManufacturerId()) > 0 {
+		ids = append(ids, rule.GetManufacturerId())
+	}
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return errors.New("invalid id in rule: " + id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product_service/config"
+	"product_service/genproto/organization_service"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/logger"
+	"product_service/storage"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GoodsReceiptService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	products *ProductService
+	*product_service.UnimplementedGoodsReceiptServiceServer
+}
+
+func NewGoodsReceiptService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *GoodsReceiptService {
+	return &GoodsReceiptService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+		products: NewProductService(cfg, log, strg, srvs),
+	}
+}
+
+func (i *GoodsReceiptService) Create(ctx context.Context, req *product_service.CreateGoodsReceipt) (resp *product_service.GoodsReceipt, err error) {
+
+	i.log.Info("---CreateGoodsReceipt------>", logger.Any("req", req))
+
+	if len(req.GetCurrency()) == 0 {
+		req.Currency = i.cfg.BaseCurrency
+	}
+
+	err = i.validateGoodsReceipt(ctx, req.GetProviderId(), req.GetMagazinId(), req.GetCurrency(), req.GetLines())
+	if err != nil {
+		return nil, err
+	}
+
+	req.StaffId = staffId(ctx, req.GetStaffId())
+
+	pKey, err := i.strg.GoodsReceipt().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreateGoodsReceipt->GoodsReceipt->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return i.GetByID(ctx, pKey)
+}
+
+func (i *GoodsReceiptService) GetByID(ctx context.Context, req *product_service.GoodsReceiptPK) (resp *product_service.GoodsReceipt, err error) {
+
+	i.log.Info("---GetGoodsReceiptByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.GoodsReceipt().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetGoodsReceiptByID->GoodsReceipt->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	hideReceiptCost(ctx, resp)
+
+	return
+}
+
+func (i *GoodsReceiptService) GetList(ctx context.Context, req *product_service.GetListGoodsReceiptRequest) (resp *product_service.GetListGoodsReceiptResponse, err error) {
+
+	i.log.Info("---GetGoodsReceipts------>", logger.Any("req", req))
+
+	resp, err = i.strg.GoodsReceipt().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetGoodsReceipts->GoodsReceipt->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hideReceiptCost(ctx, resp.Receipts...)
+
+	return
+}
+
+func (i *GoodsReceiptService) Update(ctx context.Context, req *product_service.UpdateGoodsReceipt) (resp *product_service.GoodsReceipt, err error) {
+
+	i.log.Info("---UpdateGoodsReceipt------>", logger.Any("req", req))
+
+	if len(req.GetCurrency()) == 0 {
+		req.Currency = i.cfg.BaseCurrency
+	}
+
+	err = i.validateGoodsReceipt(ctx, req.GetProviderId(), req.GetMagazinId(), req.GetCurrency(), req.GetLines())
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := i.strg.GoodsReceipt().Update(ctx, req)
+	if err != nil {
+		i.log.Error("!!!UpdateGoodsReceipt--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "receipt does not exist or is not a draft")
+	}
+
+	return i.GetByID(ctx, &product_service.GoodsReceiptPK{Id: req.Id})
+}
+
+func (i *GoodsReceiptService) Delete(ctx context.Context, req *product_service.GoodsReceiptPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteGoodsReceipt------>", logger.Any("req", req))
+
+	rowsAffected, err := i.strg.GoodsReceipt().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteGoodsReceipt->GoodsReceipt->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "receipt does not exist or is not a draft")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// Post receives the goods of a draft receipt into the magazin and updates the cost prices, all or nothing
+func (i *GoodsReceiptService) Post(ctx context.Context, req *product_service.PostGoodsReceiptRequest) (resp *product_service.GoodsReceipt, err error) {
+
+	i.log.Info("---PostGoodsReceipt------>", logger.Any("req", req))
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	req.StaffId = staffId(ctx, req.GetStaffId())
+
+	rowsAffected, err := i.strg.GoodsReceipt().Post(ctx, req)
+	if err != nil {
+		i.log.Error("!!!PostGoodsReceipt->GoodsReceipt->Post--->", logger.Error(err))
+		return nil, stockError(err)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "receipt does not exist or is not a draft")
+	}
+
+	return i.GetByID(ctx, &product_service.GoodsReceiptPK{Id: req.Id})
+}
+
+// validateGoodsReceipt checks the provider, the magazin, the currency and the lines of a receipt
+func (i *GoodsReceiptService) validateGoodsReceipt(ctx context.Context, providerId, magazinId, currency string, lines []*product_service.GoodsReceiptLine) error {
+	if len(providerId) == 0 || len(magazinId) == 0 {
+		return status.Error(codes.InvalidArgument, "provider_id and magazin_id are required")
+	}
+
+	err := validateReceiptLines(lines)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, line := range lines {
+		if line.GetCostPrice() > 0 && !canSeeCost(ctx) {
+			return status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+		}
+	}
+
+	err = i.products.checkCurrency(ctx, currency)
+	if err != nil {
+		return err
+	}
+
+	_, err = i.services.ProviderService().GetByID(ctx, &organization_service.ProviderPK{Id: providerId})
+	if err != nil {
+		i.log.Error("!!!ValidateGoodsReceipt->ProviderService->Get--->", logger.Error(err))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = i.services.MagazinService().GetByID(ctx, &organization_service.MagazinPK{Id: magazinId})
+	if err != nil {
+		i.log.Error("!!!ValidateGoodsReceipt->MagazinService->Get--->", logger.Error(err))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
+func validateReceiptLines(lines []*product_service.GoodsReceiptLine) error {
+	if len(lines) == 0 {
+		return errors.New("lines are required")
+	}
+
+	for _, line := range lines {
+		if len(line.GetProductId()) == 0 {
+			return errors.New("product_id is required")
+		}
+		if line.GetQuantity() <= 0 {
+			return errors.New("quantity must be greater than zero")
+		}
+		if line.GetCostPrice() < 0 {
+			return errors.New("cost_price must not be negative")
+		}
+		if len(line.GetExpiryDate()) > 0 {
+			if _, err := time.Parse("2006-01-02", line.GetExpiryDate()); err != nil {
+				return errors.New("expiry_date must be in YYYY-MM-DD format")
+			}
+		}
+	}
+
+	return nil
+}
+
+// hideReceiptCost clears the line costs and the totals unless the caller may see cost data
+func hideReceiptCost(ctx context.Context, receipts ...*product_service.GoodsReceipt) {
+	if canSeeCost(ctx) {
+		return
+	}
+
+	for _, receipt := range receipts {
+		receipt.Total = 0
+		for _, line := range receipt.Lines {
+			line.CostPrice = 0
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product_service/config"
+	"product_service/genproto/organization_service"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/models"
+	"product_service/pkg/helper"
+	"product_service/pkg/logger"
+	"product_service/storage"
+	"sort"
+
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type InventoryService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*product_service.UnimplementedInventoryServiceServer
+}
+
+func NewInventoryService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *InventoryService {
+	return &InventoryService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *InventoryService) GetStock(ctx context.Context, req *product_service.GetStockRequest) (resp *product_service.Stock, err error) {
+
+	i.log.Info("---GetStock------>", logger.Any("req", req))
+
+	if len(req.GetProductId()) == 0 || len(req.GetMagazinId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id and magazin_id are required")
+	}
+
+	resp, err = i.strg.Stock().Get(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetStock->Stock->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return
+}
+
+func (i *InventoryService) ListStock(ctx context.Context, req *product_service.ListStockRequest) (resp *product_service.ListStockResponse, err error) {
+
+	i.log.Info("---ListStock------>", logger.Any("req", req))
+
+	resp, err = i.strg.Stock().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!ListStock->Stock->GetList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+// AdjustStock records the deltas as movements of one type in the magazin, nothing changes when one of them is refused
+func (i *InventoryService) AdjustStock(ctx context.Context, req *product_service.AdjustStockRequest) (resp *product_service.AdjustStockResponse, err error) {
+
+	i.log.Info("---AdjustStock------>", logger.Any("req", req))
+
+	if len(req.GetMagazinId()) == 0 || len(req.GetItems()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "magazin_id and items are required")
+	}
+
+	if len(req.GetType()) == 0 {
+		req.Type = config.MovementTypeAdjustment
+	}
+
+	movements, err := stockMovements(req.GetMagazinId(), req.GetItems(), &product_service.StockMovement{
+		Type:       req.GetType(),
+		DocumentId: req.GetDocumentId(),
+		StaffId:    staffId(ctx, req.GetStaffId()),
+		Comment:    req.GetComment(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, movement := range movements {
+		err = validateMovement(movement)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	allowNegative, err := i.allowNegative(ctx, req.GetMagazinId())
+	if err != nil {
+		i.log.Error("!!!AdjustStock->Stock->GetSetting--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stocks, err := i.strg.Stock().Adjust(ctx, sortStockMovements(movements), allowNegative)
+	if err != nil {
+		i.log.Error("!!!AdjustStock->Stock->Adjust--->", logger.Error(err))
+		return nil, stockError(err)
+	}
+
+	return &product_service.AdjustStockResponse{Stocks: stocks}, nil
+}
+
+// TransferStock moves the quantities from one magazin to another in one transaction
+func (i *InventoryService) TransferStock(ctx context.Context, req *product_service.TransferStockRequest) (resp *product_service.AdjustStockResponse, err error) {
+
+	i.log.Info("---TransferStock------>", logger.Any("req", req))
+
+	if len(req.GetFromMagazinId()) == 0 || len(req.GetToMagazinId()) == 0 || len(req.GetItems()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "from_magazin_id, to_magazin_id and items are required")
+	}
+	if req.GetFromMagazinId() == req.GetToMagazinId() {
+		return nil, status.Error(codes.InvalidArgument, "cannot transfer within the same magazin")
+	}
+
+	template := &product_service.StockMovement{
+		Type:       config.MovementTypeTransfer,
+		DocumentId: req.GetDocumentId(),
+		StaffId:    staffId(ctx, req.GetStaffId()),
+		Comment:    req.GetComment(),
+	}
+
+	incoming, err := stockMovements(req.GetToMagazinId(), req.GetItems(), template)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	movements := make([]*product_service.StockMovement, 0, 2*len(incoming))
+	for _, movement := range incoming {
+		if movement.Quantity <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "transferred quantities must be positive")
+		}
+
+		outgoing := proto.Clone(movement).(*product_service.StockMovement)
+		outgoing.MagazinId = req.GetFromMagazinId()
+		outgoing.Quantity = -movement.Quantity
+
+		movements = append(movements, outgoing, movement)
+	}
+
+	// only the source magazin loses stock, so its setting is the one that applies
+	allowNegative, err := i.allowNegative(ctx, req.GetFromMagazinId())
+	if err != nil {
+		i.log.Error("!!!TransferStock->Stock->GetSetting--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stocks, err := i.strg.Stock().Adjust(ctx, sortStockMovements(movements), allowNegative)
+	if err != nil {
+		i.log.Error("!!!TransferStock->Stock->Adjust--->", logger.Error(err))
+		return nil, stockError(err)
+	}
+
+	return &product_service.AdjustStockResponse{Stocks: stocks}, nil
+}
+
+// ListMovements returns the ledger entries, newest first
+func (i *InventoryService) ListMovements(ctx context.Context, req *product_service.ListMovementsRequest) (resp *product_service.ListMovementsResponse, err error) {
+
+	i.log.Info("---ListMovements------>", logger.Any("req", req))
+
+	resp, err = i.strg.Stock().GetMovements(ctx, req)
+	if err != nil {
+		i.log.Error("!!!ListMovements->Stock->GetMovements--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+// SetStockSetting decides whether the stock of the magazin may go below zero
+func (i *InventoryService) SetStockSetting(ctx context.Context, req *product_service.SetStockSettingRequest) (resp *product_service.StockSetting, err error) {
+
+	i.log.Info("---SetStockSetting------>", logger.Any("req", req))
+
+	if len(req.GetMagazinId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "magazin_id is required")
+	}
+
+	_, err = i.services.MagazinService().GetByID(ctx, &organization_service.MagazinPK{Id: req.GetMagazinId()})
+	if err != nil {
+		i.log.Error("!!!SetStockSetting->MagazinService->GetByID--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.Stock().SetSetting(ctx, req)
+	if err != nil {
+		i.log.Error("!!!SetStockSetting->Stock->SetSetting--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+// GetStockSetting returns the setting of the magazin, or the service default when it has none
+func (i *InventoryService) GetStockSetting(ctx context.Context, req *product_service.StockSettingPK) (resp *product_service.StockSetting, err error) {
+
+	i.log.Info("---GetStockSetting------>", logger.Any("req", req))
+
+	resp, err = i.strg.Stock().GetSetting(ctx, req)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &product_service.StockSetting{MagazinId: req.GetMagazinId(), AllowNegative: i.cfg.AllowNegativeStock}, nil
+	}
+	if err != nil {
+		i.log.Error("!!!GetStockSetting->Stock->GetSetting--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *InventoryService) allowNegative(ctx context.Context, magazinId string) (bool, error) {
+	setting, err := i.strg.Stock().GetSetting(ctx, &product_service.StockSettingPK{MagazinId: magazinId})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return i.cfg.AllowNegativeStock, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return setting.AllowNegative, nil
+}
+
+// stockMovements turns the deltas into movements of the magazin described by the template,
+// the deltas of a repeated product are summed into one movement
+func stockMovements(magazinId string, items []*product_service.StockDelta, template *product_service.StockMovement) ([]*product_service.StockMovement, error) {
+	byProduct := make(map[string]*product_service.StockMovement, len(items))
+	movements := make([]*product_service.StockMovement, 0, len(items))
+
+	for _, item := range items {
+		if len(item.GetProductId()) == 0 {
+			return nil, errors.New("product_id is required")
+		}
+		if item.GetDelta() == 0 {
+			return nil, errors.New("delta must not be zero")
+		}
+
+		if existing, ok := byProduct[item.GetProductId()]; ok {
+			existing.Quantity += item.GetDelta()
+			continue
+		}
+
+		movement := proto.Clone(template).(*product_service.StockMovement)
+		movement.ProductId = item.GetProductId()
+		movement.MagazinId = magazinId
+		movement.Quantity = item.GetDelta()
+
+		byProduct[item.GetProductId()] = movement
+		movements = append(movements, movement)
+	}
+
+	return movements, nil
+}
+
+// sortStockMovements orders the movements by product and magazin, the order every transaction locks stock rows in
+func sortStockMovements(movements []*product_service.StockMovement) []*product_service.StockMovement {
+	sort.Slice(movements, func(a, b int) bool {
+		if movements[a].ProductId != movements[b].ProductId {
+			return movements[a].ProductId < movements[b].ProductId
+		}
+		return movements[a].MagazinId < movements[b].MagazinId
+	})
+
+	return movements
+}
+
+// validateMovement checks that the quantity goes the way the movement type does
+func validateMovement(movement *product_service.StockMovement) error {
+	switch movement.GetType() {
+	case config.MovementTypeReceipt, config.MovementTypeReturn:
+		if movement.GetQuantity() <= 0 {
+			return errors.New(movement.GetType() + " quantities must be positive")
+		}
+	case config.MovementTypeSale, config.MovementTypeWriteOff:
+		if movement.GetQuantity() >= 0 {
+			return errors.New(movement.GetType() + " quantities must be negative")
+		}
+	case config.MovementTypeAdjustment:
+		if movement.GetQuantity() == 0 {
+			return errors.New("adjustment quantities must not be zero")
+		}
+	case config.MovementTypeTransfer:
+		return errors.New("transfers are made with TransferStock")
+	default:
+		return errors.New("type must be receipt, sale, return, write_off or adjustment")
+	}
+
+	return nil
+}
+
+// staffId returns the staff given in the request, or the actor of the call
+func staffId(ctx context.Context, requested string) string {
+	if len(requested) > 0 {
+		return requested
+	}
+
+	return helper.GetActor(ctx)
+}
+
+// stockError maps a refused stock change to FailedPrecondition so callers can tell it from bad input
+func stockError(err error) error {
+	var insufficient *models.InsufficientStockError
+	if errors.As(err, &insufficient) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.InvalidArgument, err.Error())
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/logger"
+	"product_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ManufacturerService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*product_service.UnimplementedManufacturerServiceServer
+}
+
+func NewManufacturerService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *ManufacturerService {
+	return &ManufacturerService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *ManufacturerService) Create(ctx context.Context, req *product_service.CreateManufacturer) (resp *product_service.Manufacturer, err error) {
+
+	i.log.Info("---CreateManufacturer------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	pKey, err := i.strg.Manufacturer().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreateManufacturer->Manufacturer->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.Manufacturer().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyManufacturer->Manufacturer->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ManufacturerService) GetByID(ctx context.Context, req *product_service.ManufacturerPK) (resp *product_service.Manufacturer, err error) {
+
+	i.log.Info("---GetManufacturerByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.Manufacturer().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetManufacturerByID->Manufacturer->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ManufacturerService) GetList(ctx context.Context, req *product_service.GetListManufacturerRequest) (resp *product_service.GetListManufacturerResponse, err error) {
+
+	i.log.Info("---GetManufacturers------>", logger.Any("req", req))
+
+	resp, err = i.strg.Manufacturer().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetManufacturers->Manufacturer->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ManufacturerService) Update(ctx context.Context, req *product_service.UpdateManufacturer) (resp *product_service.Manufacturer, err error) {
+
+	i.log.Info("---UpdateManufacturer------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	rowsAffected, err := i.strg.Manufacturer().Update(ctx, req)
+
+	if err != nil {
+		i.log.Error("!!!UpdateManufacturer--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.Manufacturer().GetByID(ctx, &product_service.ManufacturerPK{Id: req.Id})
+	if err != nil {
+		i.log.Error("!!!GetManufacturer->Manufacturer->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
+func (i *ManufacturerService) Delete(ctx context.Context, req *product_service.ManufacturerPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteManufacturer------>", logger.Any("req", req))
+
+	err = i.strg.Manufacturer().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteManufacturer->Manufacturer->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/logger"
+	"product_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PriceListService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*product_service.UnimplementedPriceListServiceServer
+}
+
+func NewPriceListService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *PriceListService {
+	return &PriceListService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *PriceListService) Create(ctx context.Context, req *product_service.CreatePriceList) (resp *product_service.PriceList, err error) {
+
+	i.log.Info("---CreatePriceList------>", logger.Any("req", req))
+
+	pKey, err := i.strg.PriceList().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreatePriceList->PriceList->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.PriceList().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyPriceList->PriceList->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *PriceListService) GetByID(ctx context.Context, req *product_service.PriceListPK) (resp *product_service.PriceList, err error) {
+
+	i.log.Info("---GetPriceListByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.PriceList().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPriceListByID->PriceList->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *PriceListService) GetList(ctx context.Context, req *product_service.GetListPriceListRequest) (resp *product_service.GetListPriceListResponse, err error) {
+
+	i.log.Info("---GetPriceLists------>", logger.Any("req", req))
+
+	resp, err = i.strg.PriceList().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPriceLists->PriceList->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *PriceListService) Update(ctx context.Context, req *product_service.UpdatePriceList) (resp *product_service.PriceList, err error) {
+
+	i.log.Info("---UpdatePriceList------>", logger.Any("req", req))
+
+	rowsAffected, err := i.strg.PriceList().Update(ctx, req)
+
+	if err != nil {
+		i.log.Error("!!!UpdatePriceList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.PriceList().GetByID(ctx, &product_service.PriceListPK{Id: req.Id})
+	if err != nil {
+		i.log.Error("!!!GetPriceList->PriceList->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
+func (i *PriceListService) Delete(ctx context.Context, req *product_service.PriceListPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeletePriceList------>", logger.Any("req", req))
+
+	priceList, err := i.strg.PriceList().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeletePriceList->PriceList->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if priceList.IsDefault {
+		return nil, status.Error(codes.FailedPrecondition, "the default price list cannot be deleted")
+	}
+
+	err = i.strg.PriceList().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeletePriceList->PriceList->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *PriceListService) CreateItem(ctx context.Context, req *product_service.CreatePriceListItem) (resp *product_service.PriceListItem, err error) {
+
+	i.log.Info("---CreatePriceListItem------>", logger.Any("req", req))
+
+	if req.GetPrice() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "price must be greater than zero")
+	}
+
+	if req.GetMinQuantity() <= 0 {
+		req.MinQuantity = 1
+	}
+
+	pKey, err := i.strg.PriceList().CreateItem(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreatePriceListItem->PriceList->CreateItem--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.PriceList().GetItemByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyPriceListItem->PriceList->GetItem--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *PriceListService) GetItems(ctx context.Context, req *product_service.GetListPriceListItemRequest) (resp *product_service.GetListPriceListItemResponse, err error) {
+
+	i.log.Info("---GetPriceListItems------>", logger.Any("req", req))
+
+	resp, err = i.strg.PriceList().GetItems(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPriceListItems->PriceList->GetItems--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *PriceListService) DeleteItem(ctx context.Context, req *product_service.PriceListItemPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeletePriceListItem------>", logger.Any("req", req))
+
+	err = i.strg.PriceList().DeleteItem(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeletePriceListItem->PriceList->DeleteItem--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *PriceListService) GetPrice(ctx context.Context, req *product_service.GetPriceRequest) (resp *product_service.GetPriceResponse, err error) {
+
+	i.log.Info("---GetPrice------>", logger.Any("req", req))
+
+	if len(req.GetProductId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	if req.GetQuantity() <= 0 {
+		req.Quantity = 1
+	}
+
+	resp, err = i.strg.PriceList().GetPrice(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPrice->PriceList->GetPrice--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	resp.Total = float32(float64(resp.UnitPrice) * resp.Quantity)
+
+	return
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/pkg/logger"
+	"product_service/storage"
+	"time"
+)
+
+// PriceScheduler periodically applies scheduled price changes whose effective_from has passed
+type PriceScheduler struct {
+	cfg  config.Config
+	log  logger.LoggerI
+	strg storage.StorageI
+}
+
+func NewPriceScheduler(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *PriceScheduler {
+	return &PriceScheduler{
+		cfg:  cfg,
+		log:  log,
+		strg: strg,
+	}
+}
+
+func (s *PriceScheduler) Run(ctx context.Context) {
+
+	ticker := time.NewTicker(s.cfg.PriceSchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		applied, err := s.strg.Price().ApplyDueChanges(ctx)
+		if err != nil {
+			s.log.Error("!!!PriceScheduler->Price->ApplyDueChanges--->", logger.Error(err))
+		} else if applied > 0 {
+			s.log.Info("---PriceScheduler------>", logger.Any("applied", applied))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -2,16 +2,24 @@ package service
 
 import (
 	"context"
+	"errors"
+	"math"
 	"product_service/config"
+	"product_service/genproto/organization_service"
 	"product_service/genproto/product_service"
 	"product_service/grpc/client"
 	"product_service/models"
+	"product_service/pkg/helper"
 	"product_service/pkg/logger"
+	"product_service/pkg/marking"
 	"product_service/storage"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jackc/pgx/v4"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type ProductService struct {
@@ -35,6 +43,26 @@ func (i *ProductService) Create(ctx context.Context, req *product_service.Create
 
 	i.log.Info("---CreateProduct------>", logger.Any("req", req))
 
+	if req.GetCostPrice() != 0 && !canSeeCost(ctx) {
+		return nil, status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+	}
+
+	err = i.applyProductTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.createProduct(ctx, req)
+}
+
+// createProduct stores the product once the caller has decided where its fields come from
+func (i *ProductService) createProduct(ctx context.Context, req *product_service.CreateProduct) (resp *product_service.Product, err error) {
+
+	err = i.prepareProduct(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	pKey, err := i.strg.Product().Create(ctx, req)
 	if err != nil {
 		i.log.Error("!!!CreateProduct->Product->Create--->", logger.Error(err))
@@ -47,32 +75,197 @@ func (i *ProductService) Create(ctx context.Context, req *product_service.Create
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = i.applyCurrency(ctx, "", resp)
+	if err != nil {
+		i.log.Error("!!!CreateProduct->ApplyCurrency--->", logger.Error(err))
+		return nil, err
+	}
+
+	applyVat(resp)
+	hideCost(ctx, resp)
+
 	return
 }
 
+// prepareProduct validates a new product and fills in its defaults
+func (i *ProductService) prepareProduct(ctx context.Context, req *product_service.CreateProduct) (err error) {
+
+	err = validateFiscalCodes(req.GetMxikCode(), req.GetPackageCode())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	req.Gtin, err = normalizeGtin(req.GetGtin())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.GetWeightUnit()) == 0 {
+		req.WeightUnit = config.DefaultWeightUnit
+	}
+	if len(req.GetDimensionUnit()) == 0 {
+		req.DimensionUnit = config.DefaultDimensionUnit
+	}
+	err = validateLogistics(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.GetCurrency()) == 0 {
+		req.Currency = i.cfg.BaseCurrency
+	}
+	if len(req.GetCostCurrency()) == 0 {
+		req.CostCurrency = req.Currency
+	}
+	for _, currency := range []string{req.Currency, req.CostCurrency} {
+		if err = i.checkCurrency(ctx, currency); err != nil {
+			return err
+		}
+	}
+
+	if len(req.GetUnitId()) == 0 {
+		req.UnitId = config.DefaultUnitId
+	}
+
+	req.ManufacturerId, err = i.brandManufacturer(ctx, req.GetBrandId(), req.GetManufacturerId())
+	if err != nil {
+		return err
+	}
+
+	return i.checkBarcodeFree(ctx, req.GetBarcode(), "")
+}
+
 func (i *ProductService) GetByID(ctx context.Context, req *product_service.ProductPK) (resp *product_service.Product, err error) {
 
 	i.log.Info("---GetProductByID------>", logger.Any("req", req))
 
 	resp, err = i.strg.Product().GetByID(ctx, req)
+	if errors.Is(err, pgx.ErrNoRows) && len(req.GetAsOf()) == 0 {
+		// the product may have been merged into another one
+		redirect, redirectErr := i.strg.Product().GetRedirect(ctx, req.GetId())
+		if redirectErr == nil {
+			resp, err = i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: redirect.Id})
+		}
+	}
 	if err != nil {
 		i.log.Error("!!!GetProductByID->Product->Get--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = i.applyBundlePrices(ctx, resp)
+	if err != nil {
+		i.log.Error("!!!GetProductByID->ApplyBundlePrices--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.applyStorePrices(ctx, []*product_service.Product{resp}, req.GetFilialId(), req.GetMagazinId())
+	if err != nil {
+		i.log.Error("!!!GetProductByID->ApplyStorePrices--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.applyCurrency(ctx, req.GetCurrency(), resp)
+	if err != nil {
+		i.log.Error("!!!GetProductByID->ApplyCurrency--->", logger.Error(err))
+		return nil, err
+	}
+
+	if req.GetWithRelations() {
+		resp.Relations, err = i.strg.ProductRelation().GetRelatedProducts(ctx, resp.Id)
+		if err != nil {
+			i.log.Error("!!!GetProductByID->ProductRelation->GetRelatedProducts--->", logger.Error(err))
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	applyVat(resp)
+	hideCost(ctx, resp)
+
 	return
 }
 
+func (i *ProductService) GetByBarcode(ctx context.Context, req *product_service.GetByBarcodeRequest) (resp *product_service.Product, err error) {
+
+	i.log.Info("---GetProductByBarcode------>", logger.Any("req", req))
+
+	pKey, err := i.strg.Product().GetIDByBarcode(ctx, req.GetBarcode())
+	if err != nil {
+		i.log.Error("!!!GetProductByBarcode->Product->GetIDByBarcode--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	resp, err = i.GetByID(ctx, &product_service.ProductPK{
+		Id:            pKey.Id,
+		FilialId:      req.GetFilialId(),
+		MagazinId:     req.GetMagazinId(),
+		Currency:      req.GetCurrency(),
+		WithRelations: req.GetWithRelations(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filialId, err := i.resolveFilialId(ctx, req.GetFilialId(), req.GetMagazinId())
+	if err != nil {
+		i.log.Error("!!!GetProductByBarcode->OrganizationService->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rules, err := i.strg.SaleRestriction().GetActive(ctx, resp.Id, resp.CategoryId, filialId)
+	if err != nil {
+		i.log.Error("!!!GetProductByBarcode->SaleRestriction->GetActive--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp.Restrictions = activeRestrictions(rules, time.Now().In(i.cfg.StoreLocation))
+
+	return resp, nil
+}
+
 func (i *ProductService) GetList(ctx context.Context, req *product_service.GetListProductRequest) (resp *product_service.GetListProductResponse, err error) {
 
 	i.log.Info("---GetProducts------>", logger.Any("req", req))
 
+	switch req.GetTagMatch() {
+	case "", config.TagMatchAny, config.TagMatchAll:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "tag_match must be any or all")
+	}
+
+	if req.GetMinWeight() < 0 || req.GetMaxWeight() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "weights must not be negative")
+	}
+	if req.GetMaxWeight() > 0 && req.GetMinWeight() > req.GetMaxWeight() {
+		return nil, status.Error(codes.InvalidArgument, "min_weight must not exceed max_weight")
+	}
+
 	resp, err = i.strg.Product().GetList(ctx, req)
 	if err != nil {
 		i.log.Error("!!!GetProducts->Product->Get--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = i.applyBundlePrices(ctx, resp.Products...)
+	if err != nil {
+		i.log.Error("!!!GetProducts->ApplyBundlePrices--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.applyStorePrices(ctx, resp.Products, req.GetFilialId(), req.GetMagazinId())
+	if err != nil {
+		i.log.Error("!!!GetProducts->ApplyStorePrices--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.applyCurrency(ctx, req.GetCurrency(), resp.Products...)
+	if err != nil {
+		i.log.Error("!!!GetProducts->ApplyCurrency--->", logger.Error(err))
+		return nil, err
+	}
+
+	applyVat(resp.Products...)
+	hideCost(ctx, resp.Products...)
+
 	return
 }
 
@@ -80,6 +273,66 @@ func (i *ProductService) Update(ctx context.Context, req *product_service.Update
 
 	i.log.Info("---UpdateProduct------>", logger.Any("req", req))
 
+	if req.GetVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, config.ErrVersionRequired)
+	}
+
+	err = validateFiscalCodes(req.GetMxikCode(), req.GetPackageCode())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	req.Gtin, err = normalizeGtin(req.GetGtin())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.GetWeightUnit()) == 0 {
+		req.WeightUnit = config.DefaultWeightUnit
+	}
+	if len(req.GetDimensionUnit()) == 0 {
+		req.DimensionUnit = config.DefaultDimensionUnit
+	}
+	err = validateLogistics(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	before, _ := i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: req.Id})
+
+	// callers that cannot see the cost send it empty, so the stored one is kept
+	if !canSeeCost(ctx) {
+		req.CostPrice = before.GetCostPrice()
+		req.CostCurrency = before.GetCostCurrency()
+	}
+
+	if len(req.GetCurrency()) == 0 {
+		req.Currency = i.cfg.BaseCurrency
+	}
+	if len(req.GetCostCurrency()) == 0 {
+		req.CostCurrency = req.Currency
+	}
+	for _, currency := range []string{req.Currency, req.CostCurrency} {
+		if err = i.checkCurrency(ctx, currency); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(req.GetUnitId()) == 0 {
+		req.UnitId = config.DefaultUnitId
+	}
+
+	req.ManufacturerId, err = i.brandManufacturer(ctx, req.GetBrandId(), req.GetManufacturerId())
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty barcode keeps the stored one
+	err = i.checkBarcodeFree(ctx, req.GetBarcode(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
 	rowsAffected, err := i.strg.Product().Update(ctx, req)
 
 	if err != nil {
@@ -88,7 +341,7 @@ func (i *ProductService) Update(ctx context.Context, req *product_service.Update
 	}
 
 	if rowsAffected <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+		return nil, i.staleVersionError(ctx, req.GetId())
 	}
 
 	resp, err = i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: req.Id})
@@ -97,6 +350,15 @@ func (i *ProductService) Update(ctx context.Context, req *product_service.Update
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
+	err = i.applyCurrency(ctx, "", resp)
+	if err != nil {
+		i.log.Error("!!!UpdateProduct->ApplyCurrency--->", logger.Error(err))
+		return nil, err
+	}
+
+	applyVat(resp)
+	hideCost(ctx, resp)
+
 	return resp, err
 }
 
@@ -104,9 +366,56 @@ func (i *ProductService) UpdatePatch(ctx context.Context, req *product_service.U
 
 	i.log.Info("---UpdatePatchProduct------>", logger.Any("req", req))
 
+	if req.GetVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, config.ErrVersionRequired)
+	}
+
 	updatePatchModel := models.UpdatePatchRequest{
-		Id:     req.GetId(),
-		Fields: req.GetFields().AsMap(),
+		Id:      req.GetId(),
+		Version: req.GetVersion(),
+		Fields:  req.GetFields().AsMap(),
+	}
+
+	for _, field := range []string{"cost_price", "cost_currency"} {
+		if _, ok := updatePatchModel.Fields[field]; ok && !canSeeCost(ctx) {
+			return nil, status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+		}
+	}
+
+	for _, field := range []string{"currency", "cost_currency"} {
+		if value, ok := updatePatchModel.Fields[field]; ok {
+			currency, _ := value.(string)
+			if err = i.checkCurrency(ctx, currency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	mxikCode, _ := updatePatchModel.Fields["mxik_code"].(string)
+	packageCode, _ := updatePatchModel.Fields["package_code"].(string)
+	err = validateFiscalCodes(mxikCode, packageCode)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if value, ok := updatePatchModel.Fields["gtin"]; ok {
+		gtin, _ := value.(string)
+		gtin, err = normalizeGtin(gtin)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		// an empty GTIN clears it
+		updatePatchModel.Fields["gtin"] = helper.NewNullString(gtin)
+	}
+
+	if value, ok := updatePatchModel.Fields["barcode"]; ok {
+		barcode, _ := value.(string)
+		if len(barcode) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "barcode must not be empty")
+		}
+		if err = i.checkBarcodeFree(ctx, barcode, req.GetId()); err != nil {
+			return nil, err
+		}
 	}
 
 	rowsAffected, err := i.strg.Product().UpdatePatch(ctx, &updatePatchModel)
@@ -117,7 +426,7 @@ func (i *ProductService) UpdatePatch(ctx context.Context, req *product_service.U
 	}
 
 	if rowsAffected <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+		return nil, i.staleVersionError(ctx, req.GetId())
 	}
 
 	resp, err = i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: req.Id})
@@ -127,6 +436,15 @@ func (i *ProductService) UpdatePatch(ctx context.Context, req *product_service.U
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
+	err = i.applyCurrency(ctx, "", resp)
+	if err != nil {
+		i.log.Error("!!!UpdatePatchProduct->ApplyCurrency--->", logger.Error(err))
+		return nil, err
+	}
+
+	applyVat(resp)
+	hideCost(ctx, resp)
+
 	return resp, err
 }
 
@@ -142,3 +460,1454 @@ func (i *ProductService) Delete(ctx context.Context, req *product_service.Produc
 
 	return &empty.Empty{}, nil
 }
+
+func (i *ProductService) ListProductHistory(ctx context.Context, req *product_service.ListHistoryRequest) (resp *product_service.ListHistoryResponse, err error) {
+
+	i.log.Info("---ListProductHistory------>", logger.Any("req", req))
+
+	resp, err = i.strg.Audit().GetList(ctx, models.AuditEntityProduct, req)
+	if err != nil {
+		i.log.Error("!!!ListProductHistory->Audit->GetList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !canSeeCost(ctx) {
+		for _, record := range resp.Records {
+			for _, changes := range []*structpb.Struct{record.Before, record.After, record.Diff} {
+				for _, field := range costFields {
+					delete(changes.GetFields(), field)
+				}
+			}
+		}
+	}
+
+	return
+}
+
+func (i *ProductService) SchedulePriceChange(ctx context.Context, req *product_service.SchedulePriceChangeRequest) (resp *product_service.ScheduledPriceChange, err error) {
+
+	i.log.Info("---SchedulePriceChange------>", logger.Any("req", req))
+
+	if req.GetPrice() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "price must be greater than zero")
+	}
+
+	if len(req.GetEffectiveFrom()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "effective_from is required")
+	}
+
+	resp, err = i.strg.Price().SchedulePriceChange(ctx, req)
+	if err != nil {
+		i.log.Error("!!!SchedulePriceChange->Price->SchedulePriceChange--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) GetPriceHistory(ctx context.Context, req *product_service.GetPriceHistoryRequest) (resp *product_service.GetPriceHistoryResponse, err error) {
+
+	i.log.Info("---GetPriceHistory------>", logger.Any("req", req))
+
+	resp, err = i.strg.Price().GetHistory(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPriceHistory->Price->GetHistory--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) SetPriceOverride(ctx context.Context, req *product_service.SetPriceOverrideRequest) (resp *product_service.PriceOverride, err error) {
+
+	i.log.Info("---SetPriceOverride------>", logger.Any("req", req))
+
+	if (len(req.GetFilialId()) == 0) == (len(req.GetMagazinId()) == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of filial_id and magazin_id is required")
+	}
+
+	if req.GetPrice() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "price must be greater than zero")
+	}
+
+	if len(req.GetMagazinId()) > 0 {
+		_, err = i.services.MagazinService().GetByID(ctx, &organization_service.MagazinPK{Id: req.MagazinId})
+	} else {
+		_, err = i.services.FilialService().GetByID(ctx, &organization_service.FilialPK{Id: req.FilialId})
+	}
+	if err != nil {
+		i.log.Error("!!!SetPriceOverride->OrganizationService->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pKey, err := i.strg.PriceOverride().Set(ctx, req)
+	if err != nil {
+		i.log.Error("!!!SetPriceOverride->PriceOverride->Set--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.PriceOverride().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!SetPriceOverride->PriceOverride->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) GetPriceOverrides(ctx context.Context, req *product_service.GetPriceOverridesRequest) (resp *product_service.GetPriceOverridesResponse, err error) {
+
+	i.log.Info("---GetPriceOverrides------>", logger.Any("req", req))
+
+	resp, err = i.strg.PriceOverride().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPriceOverrides->PriceOverride->GetList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) DeletePriceOverride(ctx context.Context, req *product_service.PriceOverridePK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeletePriceOverride------>", logger.Any("req", req))
+
+	err = i.strg.PriceOverride().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeletePriceOverride->PriceOverride->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *ProductService) SetProviderCost(ctx context.Context, req *product_service.SetProviderCostRequest) (resp *product_service.ProviderCost, err error) {
+
+	i.log.Info("---SetProviderCost------>", logger.Any("req", req))
+
+	if !canSeeCost(ctx) {
+		return nil, status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+	}
+
+	if req.GetCostPrice() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "cost_price must be greater than zero")
+	}
+
+	if len(req.GetCurrency()) == 0 {
+		req.Currency = i.cfg.BaseCurrency
+	}
+	if err = i.checkCurrency(ctx, req.Currency); err != nil {
+		return nil, err
+	}
+
+	_, err = i.services.ProviderService().GetByID(ctx, &organization_service.ProviderPK{Id: req.GetProviderId()})
+	if err != nil {
+		i.log.Error("!!!SetProviderCost->ProviderService->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.ProviderCost().Set(ctx, req)
+	if err != nil {
+		i.log.Error("!!!SetProviderCost->ProviderCost->Set--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) GetProviderCosts(ctx context.Context, req *product_service.GetProviderCostsRequest) (resp *product_service.GetProviderCostsResponse, err error) {
+
+	i.log.Info("---GetProviderCosts------>", logger.Any("req", req))
+
+	if !canSeeCost(ctx) {
+		return nil, status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+	}
+
+	resp, err = i.strg.ProviderCost().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetProviderCosts->ProviderCost->GetList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) RepriceByMarkup(ctx context.Context, req *product_service.RepriceByMarkupRequest) (resp *product_service.RepriceByMarkupResponse, err error) {
+
+	i.log.Info("---RepriceByMarkup------>", logger.Any("req", req))
+
+	if !canSeeCost(ctx) {
+		return nil, status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+	}
+
+	if len(req.GetCategoryId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+
+	if req.GetMarkup() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "markup must not be negative")
+	}
+
+	if req.GetRoundTo() <= 0 {
+		req.RoundTo = 1
+	}
+
+	resp, err = i.strg.Product().RepriceByMarkup(ctx, req)
+	if err != nil {
+		i.log.Error("!!!RepriceByMarkup->Product->RepriceByMarkup--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+// ValidateMarkingCode parses a scanned DataMatrix marking code and checks it belongs to the product,
+// a code that cannot be sold is reported in the response rather than as an error
+func (i *ProductService) ValidateMarkingCode(ctx context.Context, req *product_service.ValidateMarkingCodeRequest) (resp *product_service.ValidateMarkingCodeResponse, err error) {
+
+	i.log.Info("---ValidateMarkingCode------>", logger.Any("req", req))
+
+	if len(req.GetCode()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, config.ErrMarkingCodeRequired)
+	}
+
+	resp = &product_service.ValidateMarkingCodeResponse{ProductId: req.GetProductId()}
+
+	code, err := marking.Parse(req.GetCode())
+	if err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.Gtin = code.Gtin
+	resp.Serial = code.Serial
+	resp.ExpiryDate = code.Expiry
+	resp.Batch = code.Batch
+
+	if len(resp.ProductId) == 0 {
+		pKey, err := i.strg.Product().GetIDByGtin(ctx, code.Gtin)
+		if err != nil {
+			resp.Reason = config.ErrMarkingProductNotFound
+			return resp, nil
+		}
+		resp.ProductId = pKey.Id
+	}
+
+	product, err := i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: resp.ProductId})
+	if err != nil {
+		i.log.Error("!!!ValidateMarkingCode->Product->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	resp.MarkingRequired = product.MarkingRequired
+
+	if !marking.MatchesGtin(code.Gtin, product.Gtin) && !marking.MatchesBarcode(code.Gtin, product.Barcode) {
+		resp.Reason = config.ErrMarkingGtinMismatch
+		return resp, nil
+	}
+
+	resp.Valid = true
+
+	return resp, nil
+}
+
+// SetSaleRestriction creates or replaces an age, sale hours or quantity restriction of a product or a category
+func (i *ProductService) SetSaleRestriction(ctx context.Context, req *product_service.SetSaleRestrictionRequest) (resp *product_service.SaleRestriction, err error) {
+
+	i.log.Info("---SetSaleRestriction------>", logger.Any("req", req))
+
+	err = validateSaleRestriction(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.GetFilialId()) > 0 {
+		_, err = i.services.FilialService().GetByID(ctx, &organization_service.FilialPK{Id: req.FilialId})
+		if err != nil {
+			i.log.Error("!!!SetSaleRestriction->FilialService->Get--->", logger.Error(err))
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	pKey, err := i.strg.SaleRestriction().Set(ctx, req)
+	if err != nil {
+		i.log.Error("!!!SetSaleRestriction->SaleRestriction->Set--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.SaleRestriction().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!SetSaleRestriction->SaleRestriction->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) GetSaleRestrictions(ctx context.Context, req *product_service.GetSaleRestrictionsRequest) (resp *product_service.GetSaleRestrictionsResponse, err error) {
+
+	i.log.Info("---GetSaleRestrictions------>", logger.Any("req", req))
+
+	resp, err = i.strg.SaleRestriction().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetSaleRestrictions->SaleRestriction->GetList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) DeleteSaleRestriction(ctx context.Context, req *product_service.SaleRestrictionPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteSaleRestriction------>", logger.Any("req", req))
+
+	err = i.strg.SaleRestriction().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteSaleRestriction->SaleRestriction->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// SetPackaging creates or replaces a packaging level of the product, the quantity counts the parent level or base units without one
+func (i *ProductService) SetPackaging(ctx context.Context, req *product_service.SetPackagingRequest) (resp *product_service.Packaging, err error) {
+
+	i.log.Info("---SetPackaging------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if req.GetQuantity() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	if len(req.GetParentId()) > 0 && req.GetQuantity() != math.Trunc(req.GetQuantity()) {
+		return nil, status.Error(codes.InvalidArgument, "a packaging holds a whole number of its parent packagings")
+	}
+
+	rule, err := i.getQuantityRule(ctx, req.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetParentId()) == 0 && !fitsStep(req.GetQuantity(), rule.Step) {
+		return nil, status.Errorf(codes.InvalidArgument, "quantity must be a multiple of %v %s", rule.Step, rule.Unit.Code)
+	}
+
+	// check the levels still form chains down to the base unit with the change applied
+	levels := []*product_service.Packaging{}
+	found := len(req.GetId()) == 0
+	for _, packaging := range rule.Packagings {
+		if packaging.Id != req.GetId() {
+			levels = append(levels, packaging)
+		} else {
+			found = true
+		}
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "packaging is not a packaging of the product")
+	}
+	levels = append(levels, &product_service.Packaging{Id: req.GetId(), ParentId: req.GetParentId(), Quantity: req.GetQuantity()})
+
+	if _, err = packagingFactors(levels); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pKey, err := i.strg.Packaging().Set(ctx, req)
+	if err != nil {
+		i.log.Error("!!!SetPackaging->Packaging->Set--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	packagings, err := i.getPackagings(ctx, req.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, packaging := range packagings.Packagings {
+		if packaging.Id == pKey.Id {
+			return packaging, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, config.ErrNoRows)
+}
+
+func (i *ProductService) GetPackagings(ctx context.Context, req *product_service.GetPackagingsRequest) (resp *product_service.GetPackagingsResponse, err error) {
+
+	i.log.Info("---GetPackagings------>", logger.Any("req", req))
+
+	return i.getPackagings(ctx, req.GetProductId())
+}
+
+func (i *ProductService) DeletePackaging(ctx context.Context, req *product_service.PackagingPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeletePackaging------>", logger.Any("req", req))
+
+	err = i.strg.Packaging().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeletePackaging->Packaging->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetQuantityRule returns the base unit of the product, how fractional its quantities may be and its packaging levels
+func (i *ProductService) GetQuantityRule(ctx context.Context, req *product_service.ProductPK) (resp *product_service.QuantityRule, err error) {
+
+	i.log.Info("---GetQuantityRule------>", logger.Any("req", req))
+
+	return i.getQuantityRule(ctx, req.GetId())
+}
+
+// ConvertQuantity converts a quantity between packaging levels of the product, an empty level is the base unit
+func (i *ProductService) ConvertQuantity(ctx context.Context, req *product_service.ConvertQuantityRequest) (resp *product_service.ConvertQuantityResponse, err error) {
+
+	i.log.Info("---ConvertQuantity------>", logger.Any("req", req))
+
+	if req.GetQuantity() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must not be negative")
+	}
+
+	rule, err := i.getQuantityRule(ctx, req.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+
+	factors := map[string]float64{"": 1}
+	for _, packaging := range rule.Packagings {
+		factors[packaging.Id] = packaging.Factor
+	}
+
+	from, ok := factors[req.GetFromPackagingId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "from_packaging_id is not a packaging of the product")
+	}
+	to, ok := factors[req.GetToPackagingId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "to_packaging_id is not a packaging of the product")
+	}
+
+	base := req.GetQuantity() * from
+	if !fitsStep(base, rule.Step) {
+		return nil, status.Errorf(codes.InvalidArgument, "quantity must be a multiple of %v %s", rule.Step, rule.Unit.Code)
+	}
+	base = roundToStep(base, rule.Step)
+
+	return &product_service.ConvertQuantityResponse{
+		Quantity:     roundToStep(base/to, quantityPrecision),
+		BaseQuantity: base,
+		UnitId:       rule.Unit.Id,
+	}, nil
+}
+
+func (i *ProductService) getQuantityRule(ctx context.Context, productId string) (*product_service.QuantityRule, error) {
+
+	product, err := i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: productId})
+	if err != nil {
+		i.log.Error("!!!GetQuantityRule->Product->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	unitId := product.UnitId
+	if len(unitId) == 0 {
+		unitId = config.DefaultUnitId
+	}
+
+	unit, err := i.strg.Unit().GetByID(ctx, &product_service.UnitPK{Id: unitId})
+	if err != nil {
+		i.log.Error("!!!GetQuantityRule->Unit->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	packagings, err := i.getPackagings(ctx, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.QuantityRule{
+		ProductId:  productId,
+		Unit:       unit,
+		Fractional: unit.Decimals > 0,
+		Step:       math.Pow10(-int(unit.Decimals)),
+		Packagings: packagings.Packagings,
+	}, nil
+}
+
+// getPackagings lists the packaging levels of the product with their factors in base units
+func (i *ProductService) getPackagings(ctx context.Context, productId string) (*product_service.GetPackagingsResponse, error) {
+
+	resp, err := i.strg.Packaging().GetList(ctx, &product_service.GetPackagingsRequest{ProductId: productId})
+	if err != nil {
+		i.log.Error("!!!GetPackagings->Packaging->GetList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	factors, err := packagingFactors(resp.Packagings)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, packaging := range resp.Packagings {
+		packaging.Factor = factors[packaging.Id]
+	}
+
+	return resp, nil
+}
+
+// brandManufacturer returns the manufacturer of the product, taken from the brand when it is not given
+func (i *ProductService) brandManufacturer(ctx context.Context, brandId, manufacturerId string) (string, error) {
+	if len(brandId) == 0 || len(manufacturerId) > 0 {
+		return manufacturerId, nil
+	}
+
+	brand, err := i.strg.Brand().GetByID(ctx, &product_service.BrandPK{Id: brandId})
+	if err != nil {
+		i.log.Error("!!!BrandManufacturer->Brand->Get--->", logger.Error(err))
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return brand.ManufacturerId, nil
+}
+
+// TagProducts attaches every tag of the request to every product of it
+func (i *ProductService) TagProducts(ctx context.Context, req *product_service.TagProductsRequest) (resp *product_service.TagProductsResponse, err error) {
+
+	i.log.Info("---TagProducts------>", logger.Any("req", req))
+
+	if len(req.GetProductIds()) == 0 || len(req.GetTagIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_ids and tag_ids are required")
+	}
+
+	affected, err := i.strg.Tag().TagProducts(ctx, req)
+	if err != nil {
+		i.log.Error("!!!TagProducts->Tag->TagProducts--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &product_service.TagProductsResponse{AffectedCount: affected}, nil
+}
+
+// UntagProducts detaches every tag of the request from every product of it
+func (i *ProductService) UntagProducts(ctx context.Context, req *product_service.TagProductsRequest) (resp *product_service.TagProductsResponse, err error) {
+
+	i.log.Info("---UntagProducts------>", logger.Any("req", req))
+
+	if len(req.GetProductIds()) == 0 || len(req.GetTagIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_ids and tag_ids are required")
+	}
+
+	affected, err := i.strg.Tag().UntagProducts(ctx, req)
+	if err != nil {
+		i.log.Error("!!!UntagProducts->Tag->UntagProducts--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &product_service.TagProductsResponse{AffectedCount: affected}, nil
+}
+
+// SetBundle makes the product a bundle of the given components, an empty component list turns it back into a plain product
+func (i *ProductService) SetBundle(ctx context.Context, req *product_service.SetBundleRequest) (resp *product_service.Bundle, err error) {
+
+	i.log.Info("---SetBundle------>", logger.Any("req", req))
+
+	if len(req.GetProductId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	if len(req.GetComponents()) > 0 {
+		err = validateBundle(req)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		for _, component := range req.GetComponents() {
+			product, err := i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: component.GetProductId()})
+			if err != nil {
+				i.log.Error("!!!SetBundle->Product->Get--->", logger.Error(err))
+				return nil, status.Error(codes.InvalidArgument, "component "+component.GetProductId()+" not found")
+			}
+
+			if !product.Active {
+				return nil, status.Error(codes.InvalidArgument, "component "+product.Name+" is not active")
+			}
+			if product.IsBundle {
+				return nil, status.Error(codes.InvalidArgument, "component "+product.Name+" is a bundle itself")
+			}
+		}
+	} else {
+		req.Pricing = ""
+		req.Discount = 0
+	}
+
+	rowsAffected, err := i.strg.Bundle().Set(ctx, req)
+	if err != nil {
+		i.log.Error("!!!SetBundle->Bundle->Set--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+
+	return i.GetBundle(ctx, &product_service.ProductPK{Id: req.GetProductId()})
+}
+
+// GetBundle returns the components of the bundle together with its resulting price
+func (i *ProductService) GetBundle(ctx context.Context, req *product_service.ProductPK) (resp *product_service.Bundle, err error) {
+
+	i.log.Info("---GetBundle------>", logger.Any("req", req))
+
+	product, err := i.strg.Product().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetBundle->Product->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	components, err := i.strg.Bundle().GetComponents(ctx, product.Id)
+	if err != nil {
+		i.log.Error("!!!GetBundle->Bundle->GetComponents--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.applyBundlePrices(ctx, product)
+	if err != nil {
+		i.log.Error("!!!GetBundle->ApplyBundlePrices--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	totals, err := i.strg.Bundle().GetComponentTotals(ctx, []string{product.Id})
+	if err != nil {
+		i.log.Error("!!!GetBundle->Bundle->GetComponentTotals--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	total, ok := totals[product.Id]
+	if !ok && len(components) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, config.ErrNoComponentRate)
+	}
+
+	resp = &product_service.Bundle{
+		ProductId:       product.Id,
+		Pricing:         product.BundlePricing,
+		Discount:        product.BundleDiscount,
+		Components:      components,
+		Price:           product.EffectivePrice,
+		ComponentsTotal: float32(total),
+	}
+
+	return resp, nil
+}
+
+// ExpandBundle returns the products and quantities to deduct from stock when the product is sold,
+// a plain product expands to itself
+func (i *ProductService) ExpandBundle(ctx context.Context, req *product_service.ExpandBundleRequest) (resp *product_service.ExpandBundleResponse, err error) {
+
+	i.log.Info("---ExpandBundle------>", logger.Any("req", req))
+
+	quantity := req.GetQuantity()
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
+	}
+
+	product, err := i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: req.GetProductId()})
+	if err != nil {
+		i.log.Error("!!!ExpandBundle->Product->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if !product.IsBundle {
+		return &product_service.ExpandBundleResponse{
+			Components: []*product_service.BundleComponent{{
+				ProductId: product.Id,
+				Name:      product.Name,
+				Quantity:  quantity,
+				Price:     product.Price,
+			}},
+		}, nil
+	}
+
+	components, err := i.strg.Bundle().GetComponents(ctx, product.Id)
+	if err != nil {
+		i.log.Error("!!!ExpandBundle->Bundle->GetComponents--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, component := range components {
+		component.Quantity *= quantity
+	}
+
+	return &product_service.ExpandBundleResponse{Components: components}, nil
+}
+
+// SetProductRelation links the related product to the product with the given type, setting an existing link again only moves it
+func (i *ProductService) SetProductRelation(ctx context.Context, req *product_service.SetProductRelationRequest) (resp *product_service.ProductRelation, err error) {
+
+	i.log.Info("---SetProductRelation------>", logger.Any("req", req))
+
+	err = validateProductRelation(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, id := range []string{req.GetProductId(), req.GetRelatedId()} {
+		_, err = i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: id})
+		if err != nil {
+			i.log.Error("!!!SetProductRelation->Product->Get--->", logger.Error(err))
+			return nil, status.Error(codes.InvalidArgument, "product "+id+" not found")
+		}
+	}
+
+	pKey, err := i.strg.ProductRelation().Set(ctx, req)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		i.log.Error("!!!SetProductRelation->ProductRelation->Set--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.ProductRelation().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!SetProductRelation->ProductRelation->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) GetProductRelations(ctx context.Context, req *product_service.GetProductRelationsRequest) (resp *product_service.GetProductRelationsResponse, err error) {
+
+	i.log.Info("---GetProductRelations------>", logger.Any("req", req))
+
+	resp, err = i.strg.ProductRelation().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetProductRelations->ProductRelation->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) DeleteProductRelation(ctx context.Context, req *product_service.ProductRelationPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteProductRelation------>", logger.Any("req", req))
+
+	err = i.strg.ProductRelation().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteProductRelation->ProductRelation->Delete--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// CloneProduct creates a copy of the product with a new barcode, its tags, relations and bundle components are copied with it,
+// non-empty fields of the request override the copied ones
+func (i *ProductService) CloneProduct(ctx context.Context, req *product_service.CloneProductRequest) (resp *product_service.Product, err error) {
+
+	i.log.Info("---CloneProduct------>", logger.Any("req", req))
+
+	if req.GetCostPrice() != 0 && !canSeeCost(ctx) {
+		return nil, status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+	}
+
+	source, err := i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: req.GetId()})
+	if err != nil {
+		i.log.Error("!!!CloneProduct->Product->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	clone := &product_service.CreateProduct{
+		Photo:            source.Photo,
+		Name:             source.Name,
+		CategoryId:       source.CategoryId,
+		Price:            source.Price,
+		TaxRateId:        source.TaxRateId,
+		MxikCode:         source.MxikCode,
+		PackageCode:      source.PackageCode,
+		PriceExcludesVat: source.PriceExcludesVat,
+		RequiresMarking:  source.RequiresMarking,
+		Currency:         source.Currency,
+		CostCurrency:     source.CostCurrency,
+		UnitId:           source.UnitId,
+		BrandId:          source.BrandId,
+		ManufacturerId:   source.ManufacturerId,
+		NetWeight:        source.NetWeight,
+		GrossWeight:      source.GrossWeight,
+		WeightUnit:       source.WeightUnit,
+		Length:           source.Length,
+		Width:            source.Width,
+		Height:           source.Height,
+		DimensionUnit:    source.DimensionUnit,
+		TemperatureClass: source.TemperatureClass,
+	}
+	if canSeeCost(ctx) {
+		clone.CostPrice = source.CostPrice
+	}
+
+	if len(req.GetName()) > 0 {
+		clone.Name = req.GetName()
+	}
+	if len(req.GetCategoryId()) > 0 {
+		clone.CategoryId = req.GetCategoryId()
+	}
+	if len(req.GetPhoto()) > 0 {
+		clone.Photo = req.GetPhoto()
+	}
+	if req.GetPrice() > 0 {
+		clone.Price = req.GetPrice()
+	}
+	if req.GetCostPrice() > 0 {
+		clone.CostPrice = req.GetCostPrice()
+	}
+	clone.Barcode = req.GetBarcode()
+
+	err = i.prepareProduct(ctx, clone)
+	if err != nil {
+		return nil, err
+	}
+
+	pKey, err := i.strg.Product().Clone(ctx, source.Id, clone)
+	if err != nil {
+		i.log.Error("!!!CloneProduct->Product->Clone--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return i.GetByID(ctx, pKey)
+}
+
+// FindDuplicates lists the pairs of products that are likely the same item, best matches first
+func (i *ProductService) FindDuplicates(ctx context.Context, req *product_service.FindDuplicatesRequest) (resp *product_service.FindDuplicatesResponse, err error) {
+
+	i.log.Info("---FindDuplicates------>", logger.Any("req", req))
+
+	if req.GetMinScore() < 0 || req.GetMinScore() > 1 {
+		return nil, status.Error(codes.InvalidArgument, "min_score must be between 0 and 1")
+	}
+	if req.GetMinScore() == 0 {
+		req.MinScore = config.DefaultDuplicateScore
+	}
+
+	resp, err = i.strg.Product().FindDuplicates(ctx, req)
+	if err != nil {
+		i.log.Error("!!!FindDuplicates->Product->FindDuplicates--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+// MergeProducts folds the merged products into the kept one, their ids and barcodes keep resolving to it afterwards
+func (i *ProductService) MergeProducts(ctx context.Context, req *product_service.MergeProductsRequest) (resp *product_service.Product, err error) {
+
+	i.log.Info("---MergeProducts------>", logger.Any("req", req))
+
+	mergeIds := helper.UniqueStrings(req.GetMergeIds())
+	if len(req.GetKeepId()) == 0 || len(mergeIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "keep_id and merge_ids are required")
+	}
+
+	_, err = i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: req.GetKeepId()})
+	if err != nil {
+		i.log.Error("!!!MergeProducts->Product->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	for _, id := range mergeIds {
+		if id == req.GetKeepId() {
+			return nil, status.Error(codes.InvalidArgument, "product cannot be merged into itself")
+		}
+
+		_, err = i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: id})
+		if err != nil {
+			i.log.Error("!!!MergeProducts->Product->Get--->", logger.Error(err))
+			return nil, status.Error(codes.NotFound, "product "+id+" not found")
+		}
+	}
+
+	err = i.strg.Product().Merge(ctx, req.GetKeepId(), mergeIds)
+	if err != nil {
+		i.log.Error("!!!MergeProducts->Product->Merge--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: req.GetKeepId()})
+	if err != nil {
+		i.log.Error("!!!MergeProducts->Product->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.applyCurrency(ctx, "", resp)
+	if err != nil {
+		i.log.Error("!!!MergeProducts->ApplyCurrency--->", logger.Error(err))
+		return nil, err
+	}
+
+	applyVat(resp)
+	hideCost(ctx, resp)
+
+	return
+}
+
+// applyProductTemplate fills the fields the request leaves empty from the given template,
+// or from the template of the category when no template is given
+func (i *ProductService) applyProductTemplate(ctx context.Context, req *product_service.CreateProduct) error {
+	var (
+		template *product_service.ProductTemplate
+		err      error
+	)
+
+	if len(req.GetTemplateId()) > 0 {
+		template, err = i.strg.ProductTemplate().GetByID(ctx, &product_service.ProductTemplatePK{Id: req.GetTemplateId()})
+		if err != nil {
+			i.log.Error("!!!CreateProduct->ProductTemplate->Get--->", logger.Error(err))
+			return status.Error(codes.InvalidArgument, "template "+req.GetTemplateId()+" not found")
+		}
+		if len(req.GetCategoryId()) == 0 {
+			req.CategoryId = template.CategoryId
+		}
+	} else if len(req.GetCategoryId()) > 0 {
+		template, err = i.strg.ProductTemplate().GetByCategory(ctx, req.GetCategoryId())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			i.log.Error("!!!CreateProduct->ProductTemplate->GetByCategory--->", logger.Error(err))
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else {
+		return nil
+	}
+
+	helper.FillDefaults(req, template.Defaults)
+
+	return nil
+}
+
+// SetExchangeRate stores how many units of the base currency one unit of the currency is worth from the effective date
+func (i *ProductService) SetExchangeRate(ctx context.Context, req *product_service.SetExchangeRateRequest) (resp *product_service.ExchangeRate, err error) {
+
+	i.log.Info("---SetExchangeRate------>", logger.Any("req", req))
+
+	if !helper.ValidCurrency(req.GetCurrency()) {
+		return nil, status.Error(codes.InvalidArgument, config.ErrInvalidCurrency)
+	}
+
+	if req.GetCurrency() == i.cfg.BaseCurrency {
+		return nil, status.Error(codes.InvalidArgument, "the rate of the base currency is always 1")
+	}
+
+	if req.GetRate() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "rate must be greater than zero")
+	}
+
+	if len(req.GetEffectiveFrom()) == 0 {
+		req.EffectiveFrom = time.Now().Format(config.DatabaseTimeLayout)
+	}
+
+	resp, err = i.strg.ExchangeRate().Set(ctx, req)
+	if err != nil {
+		i.log.Error("!!!SetExchangeRate->ExchangeRate->Set--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductService) GetExchangeRates(ctx context.Context, req *product_service.GetExchangeRatesRequest) (resp *product_service.GetExchangeRatesResponse, err error) {
+
+	i.log.Info("---GetExchangeRates------>", logger.Any("req", req))
+
+	resp, err = i.strg.ExchangeRate().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetExchangeRates->ExchangeRate->GetList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+// checkCurrency makes sure amounts in the currency can be converted, the base currency always can
+func (i *ProductService) checkCurrency(ctx context.Context, currency string) error {
+	if !helper.ValidCurrency(currency) {
+		return status.Error(codes.InvalidArgument, config.ErrInvalidCurrency)
+	}
+
+	if currency == i.cfg.BaseCurrency {
+		return nil
+	}
+
+	rates, err := i.strg.ExchangeRate().GetRates(ctx, time.Now())
+	if err != nil {
+		i.log.Error("!!!CheckCurrency->ExchangeRate->GetRates--->", logger.Error(err))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, ok := rates[currency]; !ok {
+		return status.Errorf(codes.FailedPrecondition, config.ErrNoExchangeRate, currency)
+	}
+
+	return nil
+}
+
+// applyCurrency converts the prices to the requested currency, without one they stay in the product currency.
+// Margin and markup are recomputed with the cost converted to the price currency
+func (i *ProductService) applyCurrency(ctx context.Context, currency string, products ...*product_service.Product) error {
+	var rates map[string]float64
+
+	for _, product := range products {
+		// snapshots older than the currency columns are in the base currency
+		if len(product.Currency) == 0 {
+			product.Currency = i.cfg.BaseCurrency
+		}
+		if len(product.CostCurrency) == 0 {
+			product.CostCurrency = product.Currency
+		}
+
+		target := product.Currency
+		if len(currency) > 0 {
+			target = currency
+		}
+
+		if target == product.Currency && target == product.CostCurrency {
+			continue
+		}
+
+		if rates == nil {
+			var err error
+			rates, err = i.strg.ExchangeRate().GetRates(ctx, time.Now())
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			rates[i.cfg.BaseCurrency] = 1
+		}
+
+		fromRate, ok := rates[product.Currency]
+		if !ok {
+			return status.Errorf(codes.FailedPrecondition, config.ErrNoExchangeRate, product.Currency)
+		}
+		costRate, ok := rates[product.CostCurrency]
+		if !ok {
+			return status.Errorf(codes.FailedPrecondition, config.ErrNoExchangeRate, product.CostCurrency)
+		}
+		toRate, ok := rates[target]
+		if !ok {
+			return status.Errorf(codes.FailedPrecondition, config.ErrNoExchangeRate, target)
+		}
+
+		step, ok := i.cfg.CurrencyRounding[target]
+		if !ok {
+			step = config.DefaultCurrencyRounding
+		}
+
+		if target != product.Currency {
+			product.Price = float32(helper.RoundTo(float64(product.Price)*fromRate/toRate, step))
+			product.EffectivePrice = float32(helper.RoundTo(float64(product.EffectivePrice)*fromRate/toRate, step))
+			product.Currency = target
+		}
+
+		cost := helper.RoundTo(float64(product.CostPrice)*costRate/toRate, config.DefaultCurrencyRounding)
+		if len(currency) > 0 {
+			product.CostPrice = float32(cost)
+			product.CostCurrency = target
+		}
+
+		product.Margin = float32(helper.MarginPercent(float64(product.Price), cost))
+		product.Markup = float32(helper.MarkupPercent(float64(product.Price), cost))
+	}
+
+	return nil
+}
+
+// resolveFilialId returns the filial of the store context, looking it up from the magazin when only that is known
+func (i *ProductService) resolveFilialId(ctx context.Context, filialId, magazinId string) (string, error) {
+	if len(filialId) > 0 || len(magazinId) == 0 {
+		return filialId, nil
+	}
+
+	magazin, err := i.services.MagazinService().GetByID(ctx, &organization_service.MagazinPK{Id: magazinId})
+	if err != nil {
+		return "", err
+	}
+
+	return magazin.FilialId, nil
+}
+
+// applyBundlePrices prices the bundles sold as the sum of their components minus the bundle discount,
+// fixed price bundles keep their own price
+func (i *ProductService) applyBundlePrices(ctx context.Context, products ...*product_service.Product) error {
+
+	bundleIds := make([]string, 0)
+	for _, product := range products {
+		if product.IsBundle && product.BundlePricing == config.BundlePricingSumMinusDiscount {
+			bundleIds = append(bundleIds, product.Id)
+		}
+	}
+
+	if len(bundleIds) == 0 {
+		return nil
+	}
+
+	totals, err := i.strg.Bundle().GetComponentTotals(ctx, bundleIds)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		total, ok := totals[product.Id]
+		if !ok || product.BundlePricing != config.BundlePricingSumMinusDiscount {
+			continue
+		}
+
+		product.EffectivePrice = float32(total * (1 - float64(product.BundleDiscount)/100))
+		product.PriceSource = config.PriceSourceBundle
+	}
+
+	return nil
+}
+
+// applyStorePrices sets the effective price for the store context, the price is inherited magazin -> filial -> base
+func (i *ProductService) applyStorePrices(ctx context.Context, products []*product_service.Product, filialId, magazinId string) error {
+
+	if len(products) == 0 || (len(filialId) == 0 && len(magazinId) == 0) {
+		return nil
+	}
+
+	filialId, err := i.resolveFilialId(ctx, filialId, magazinId)
+	if err != nil {
+		return err
+	}
+
+	productIds := make([]string, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, product.Id)
+	}
+
+	overrides, err := i.strg.PriceOverride().GetEffective(ctx, productIds, filialId, magazinId)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		override, ok := overrides[product.Id]
+		if !ok {
+			continue
+		}
+
+		product.EffectivePrice = override.Price
+		product.PriceSource = config.PriceSourceFilial
+		if len(override.MagazinId) > 0 {
+			product.PriceSource = config.PriceSourceMagazin
+		}
+	}
+
+	return nil
+}
+
+// staleVersionError tells a missing product from one changed since the version the caller read
+func (i *ProductService) staleVersionError(ctx context.Context, id string) error {
+
+	current, err := i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: id})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Error(codes.NotFound, "product not found")
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Errorf(codes.Aborted, config.ErrStaleVersion, current.Version)
+}
+
+var costFields = []string{"cost_price", "margin", "markup"}
+
+// checkBarcodeFree returns AlreadyExists when the barcode resolves to a product other than productId
+func (i *ProductService) checkBarcodeFree(ctx context.Context, barcode, productId string) error {
+	if len(barcode) == 0 {
+		return nil
+	}
+
+	pKey, err := i.strg.Product().GetIDByBarcode(ctx, barcode)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		i.log.Error("!!!CheckBarcodeFree->Product->GetIDByBarcode--->", logger.Error(err))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if pKey.GetId() != productId {
+		return status.Error(codes.AlreadyExists, "barcode "+barcode+" is already in use")
+	}
+
+	return nil
+}
+
+// canSeeCost reports whether the caller may read purchase cost, margin and markup,
+// the staff type comes from the verified access token only
+func canSeeCost(ctx context.Context) bool {
+	claims := helper.GetClaims(ctx)
+	if claims == nil {
+		return false
+	}
+
+	switch claims.StaffType {
+	case config.StaffTypeAdmin, config.StaffTypeManager:
+		return true
+	}
+	return false
+}
+
+// hideCost clears the cost data of the products unless the caller may see it
+func hideCost(ctx context.Context, products ...*product_service.Product) {
+	if canSeeCost(ctx) {
+		return
+	}
+
+	for _, product := range products {
+		product.CostPrice = 0
+		product.Margin = 0
+		product.Markup = 0
+	}
+}
+
+// normalizeGtin checks the check digit of the GTIN and zero pads it to 14 digits, an empty GTIN stays empty
+func normalizeGtin(gtin string) (string, error) {
+	if len(gtin) == 0 {
+		return "", nil
+	}
+	if !marking.ValidGtin(gtin) {
+		return "", errors.New(config.ErrInvalidGtin)
+	}
+
+	return marking.NormalizeGtin(gtin), nil
+}
+
+// validateFiscalCodes checks the MXIK and package codes when they are set
+func validateFiscalCodes(mxikCode, packageCode string) error {
+	if len(mxikCode) > 0 {
+		if err := helper.ValidateMxikCode(mxikCode); err != nil {
+			return err
+		}
+	}
+	if len(packageCode) > 0 {
+		if err := helper.ValidatePackageCode(packageCode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logisticsFields are the weight, size and storage fields shared by the product create and update requests
+type logisticsFields interface {
+	GetNetWeight() float64
+	GetGrossWeight() float64
+	GetWeightUnit() string
+	GetLength() float64
+	GetWidth() float64
+	GetHeight() float64
+	GetDimensionUnit() string
+	GetTemperatureClass() string
+}
+
+// validateLogistics checks the weights and dimensions, empty units are allowed for the caller to default
+func validateLogistics(req logisticsFields) error {
+	if req.GetNetWeight() < 0 || req.GetGrossWeight() < 0 {
+		return errors.New("weights must not be negative")
+	}
+	if req.GetGrossWeight() > 0 && req.GetGrossWeight() < req.GetNetWeight() {
+		return errors.New("gross_weight must not be less than net_weight")
+	}
+	if _, ok := helper.WeightUnitKg[req.GetWeightUnit()]; !ok && len(req.GetWeightUnit()) > 0 {
+		return errors.New("weight_unit must be g or kg")
+	}
+
+	if req.GetLength() < 0 || req.GetWidth() < 0 || req.GetHeight() < 0 {
+		return errors.New("dimensions must not be negative")
+	}
+	if _, ok := helper.DimensionUnitMeters[req.GetDimensionUnit()]; !ok && len(req.GetDimensionUnit()) > 0 {
+		return errors.New("dimension_unit must be mm, cm or m")
+	}
+
+	switch req.GetTemperatureClass() {
+	case "", config.TemperatureClassAmbient, config.TemperatureClassChilled, config.TemperatureClassFrozen:
+	default:
+		return errors.New("temperature_class must be ambient, chilled or frozen")
+	}
+
+	return nil
+}
+
+// applyVat splits the effective price of the products by their VAT rate
+func applyVat(products ...*product_service.Product) {
+	for _, product := range products {
+		net, vat, gross := helper.SplitVat(float64(product.EffectivePrice), float64(product.VatRate), product.PriceExcludesVat)
+		product.PriceWithoutVat = float32(net)
+		product.VatAmount = float32(vat)
+		product.PriceWithVat = float32(gross)
+	}
+}
+
+func validateBundle(req *product_service.SetBundleRequest) error {
+	switch req.GetPricing() {
+	case config.BundlePricingFixed, config.BundlePricingSumMinusDiscount:
+	default:
+		return errors.New("pricing must be fixed or sum_minus_discount")
+	}
+
+	if req.GetDiscount() < 0 || req.GetDiscount() > 100 {
+		return errors.New("discount must be between 0 and 100")
+	}
+
+	seen := make(map[string]bool, len(req.GetComponents()))
+	for _, component := range req.GetComponents() {
+		if len(component.GetProductId()) == 0 {
+			return errors.New("component product_id is required")
+		}
+		if component.GetProductId() == req.GetProductId() {
+			return errors.New("bundle cannot contain itself")
+		}
+		if seen[component.GetProductId()] {
+			return errors.New("duplicate component " + component.GetProductId())
+		}
+		if component.GetQuantity() <= 0 {
+			return errors.New("component quantity must be positive")
+		}
+		seen[component.GetProductId()] = true
+	}
+
+	return nil
+}
+
+func validateProductRelation(req *product_service.SetProductRelationRequest) error {
+	if len(req.GetProductId()) == 0 || len(req.GetRelatedId()) == 0 {
+		return errors.New("product_id and related_id are required")
+	}
+	if req.GetProductId() == req.GetRelatedId() {
+		return errors.New("product cannot be related to itself")
+	}
+	if req.GetPosition() < 0 {
+		return errors.New("position must not be negative")
+	}
+
+	switch req.GetType() {
+	case config.RelationTypeSubstitute, config.RelationTypeAccessory, config.RelationTypeUpsell, config.RelationTypeCrossSell:
+	default:
+		return errors.New("type must be substitute, accessory, upsell or cross_sell")
+	}
+
+	return nil
+}
+
+func validateSaleRestriction(req *product_service.SetSaleRestrictionRequest) error {
+	if (len(req.GetProductId()) == 0) == (len(req.GetCategoryId()) == 0) {
+		return errors.New("exactly one of product_id and category_id is required")
+	}
+	if req.GetMinAge() < 0 || req.GetMinAge() > 100 {
+		return errors.New("min_age must be between 0 and 100")
+	}
+	if req.GetMaxQuantity() < 0 {
+		return errors.New("max_quantity cannot be negative")
+	}
+	if (len(req.GetSaleFrom()) == 0) != (len(req.GetSaleTo()) == 0) {
+		return errors.New("sale_from and sale_to must be set together")
+	}
+	if len(req.GetSaleFrom()) > 0 {
+		if _, err := time.Parse(helper.DailyTimeLayout, req.GetSaleFrom()); err != nil {
+			return errors.New("sale_from must be in HH:MM format")
+		}
+		if _, err := time.Parse(helper.DailyTimeLayout, req.GetSaleTo()); err != nil {
+			return errors.New("sale_to must be in HH:MM format")
+		}
+	}
+	if req.GetMinAge() == 0 && req.GetMaxQuantity() == 0 && len(req.GetSaleFrom()) == 0 {
+		return errors.New("restriction sets no limit")
+	}
+	return nil
+}
+
+// activeRestrictions folds the rules into the strictest limits: the highest age, the lowest quantity
+// and a sale allowed only when the moment falls in every sale window
+func activeRestrictions(rules []*product_service.SaleRestriction, at time.Time) *product_service.ActiveRestrictions {
+	resp := &product_service.ActiveRestrictions{
+		SaleAllowed: true,
+		Rules:       rules,
+	}
+
+	for _, rule := range rules {
+		if rule.MinAge > resp.MinAge {
+			resp.MinAge = rule.MinAge
+		}
+		if rule.MaxQuantity > 0 && (resp.MaxQuantity == 0 || rule.MaxQuantity < resp.MaxQuantity) {
+			resp.MaxQuantity = rule.MaxQuantity
+		}
+		if !helper.InDailyWindow(rule.SaleFrom, rule.SaleTo, at) {
+			resp.SaleAllowed = false
+		}
+	}
+
+	return resp
+}
+
+// quantityPrecision is the step converted packaging quantities are rounded to
+const quantityPrecision = 0.000001
+
+// packagingFactors returns how many base units every packaging level holds, following the parents down to the base unit
+func packagingFactors(packagings []*product_service.Packaging) (map[string]float64, error) {
+	byId := make(map[string]*product_service.Packaging, len(packagings))
+	for _, packaging := range packagings {
+		byId[packaging.Id] = packaging
+	}
+
+	factors := make(map[string]float64, len(packagings))
+
+	var factor func(id string, depth int) (float64, error)
+	factor = func(id string, depth int) (float64, error) {
+		if f, ok := factors[id]; ok {
+			return f, nil
+		}
+		if depth > len(packagings) {
+			return 0, errors.New("packaging levels form a cycle")
+		}
+
+		packaging, ok := byId[id]
+		if !ok {
+			return 0, errors.New("parent packaging does not belong to the product")
+		}
+
+		f := packaging.Quantity
+		if len(packaging.ParentId) > 0 {
+			parent, err := factor(packaging.ParentId, depth+1)
+			if err != nil {
+				return 0, err
+			}
+			f *= parent
+		}
+
+		factors[id] = f
+		return f, nil
+	}
+
+	for _, packaging := range packagings {
+		if _, err := factor(packaging.Id, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	return factors, nil
+}
+
+// fitsStep reports whether the quantity is a whole number of steps
+func fitsStep(quantity, step float64) bool {
+	return math.Abs(quantity/step-math.Round(quantity/step)) < 1e-6
+}
+
+func roundToStep(quantity, step float64) float64 {
+	return math.Round(quantity/step) * step
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/helper"
+	"product_service/pkg/logger"
+	"product_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ProductTemplateService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*product_service.UnimplementedProductTemplateServiceServer
+}
+
+func NewProductTemplateService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *ProductTemplateService {
+	return &ProductTemplateService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *ProductTemplateService) Create(ctx context.Context, req *product_service.CreateProductTemplate) (resp *product_service.ProductTemplate, err error) {
+
+	i.log.Info("---CreateProductTemplate------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	err = validateProductDefaults(ctx, req.GetDefaults())
+	if err != nil {
+		return nil, err
+	}
+
+	pKey, err := i.strg.ProductTemplate().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreateProductTemplate->ProductTemplate->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.ProductTemplate().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyProductTemplate->ProductTemplate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductTemplateService) GetByID(ctx context.Context, req *product_service.ProductTemplatePK) (resp *product_service.ProductTemplate, err error) {
+
+	i.log.Info("---GetProductTemplateByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.ProductTemplate().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetProductTemplateByID->ProductTemplate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductTemplateService) GetList(ctx context.Context, req *product_service.GetListProductTemplateRequest) (resp *product_service.GetListProductTemplateResponse, err error) {
+
+	i.log.Info("---GetProductTemplates------>", logger.Any("req", req))
+
+	resp, err = i.strg.ProductTemplate().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetProductTemplates->ProductTemplate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *ProductTemplateService) Update(ctx context.Context, req *product_service.UpdateProductTemplate) (resp *product_service.ProductTemplate, err error) {
+
+	i.log.Info("---UpdateProductTemplate------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	err = validateProductDefaults(ctx, req.GetDefaults())
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := i.strg.ProductTemplate().Update(ctx, req)
+
+	if err != nil {
+		i.log.Error("!!!UpdateProductTemplate--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.ProductTemplate().GetByID(ctx, &product_service.ProductTemplatePK{Id: req.Id})
+	if err != nil {
+		i.log.Error("!!!GetProductTemplate->ProductTemplate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
+func (i *ProductTemplateService) Delete(ctx context.Context, req *product_service.ProductTemplatePK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteProductTemplate------>", logger.Any("req", req))
+
+	err = i.strg.ProductTemplate().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteProductTemplate->ProductTemplate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// validateProductDefaults checks the defaults the way CreateProduct would check them, the barcode and the GTIN are never taken from a template
+func validateProductDefaults(ctx context.Context, defaults *product_service.CreateProduct) error {
+	if defaults == nil {
+		return status.Error(codes.InvalidArgument, "defaults are required")
+	}
+
+	if defaults.GetCostPrice() != 0 && !canSeeCost(ctx) {
+		return status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+	}
+
+	err := validateFiscalCodes(defaults.GetMxikCode(), defaults.GetPackageCode())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validateLogistics(defaults)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(defaults.GetCurrency()) > 0 && !helper.ValidCurrency(defaults.GetCurrency()) {
+		return status.Error(codes.InvalidArgument, config.ErrInvalidCurrency)
+	}
+
+	defaults.Barcode = ""
+	defaults.Gtin = ""
+	defaults.TemplateId = ""
+
+	return nil
+}
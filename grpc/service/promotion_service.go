@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/helper"
+	"product_service/pkg/logger"
+	"product_service/pkg/promotion"
+	"product_service/storage"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PromotionService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*product_service.UnimplementedPromotionServiceServer
+}
+
+func NewPromotionService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *PromotionService {
+	return &PromotionService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *PromotionService) Create(ctx context.Context, req *product_service.CreatePromotion) (resp *product_service.Promotion, err error) {
+
+	i.log.Info("---CreatePromotion------>", logger.Any("req", req))
+
+	err = promotion.Validate(toPromotionRule(&product_service.Promotion{Name: req.Name, Condition: req.Condition, Action: req.Action}))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pKey, err := i.strg.Promotion().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreatePromotion->Promotion->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.Promotion().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyPromotion->Promotion->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *PromotionService) GetByID(ctx context.Context, req *product_service.PromotionPK) (resp *product_service.Promotion, err error) {
+
+	i.log.Info("---GetPromotionByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.Promotion().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPromotionByID->Promotion->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *PromotionService) GetList(ctx context.Context, req *product_service.GetListPromotionRequest) (resp *product_service.GetListPromotionResponse, err error) {
+
+	i.log.Info("---GetPromotions------>", logger.Any("req", req))
+
+	resp, err = i.strg.Promotion().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPromotions->Promotion->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *PromotionService) Update(ctx context.Context, req *product_service.UpdatePromotion) (resp *product_service.Promotion, err error) {
+
+	i.log.Info("---UpdatePromotion------>", logger.Any("req", req))
+
+	err = promotion.Validate(toPromotionRule(&product_service.Promotion{Name: req.Name, Condition: req.Condition, Action: req.Action}))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Promotion().Update(ctx, req)
+
+	if err != nil {
+		i.log.Error("!!!UpdatePromotion--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.Promotion().GetByID(ctx, &product_service.PromotionPK{Id: req.Id})
+	if err != nil {
+		i.log.Error("!!!GetPromotion->Promotion->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
+func (i *PromotionService) Delete(ctx context.Context, req *product_service.PromotionPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeletePromotion------>", logger.Any("req", req))
+
+	err = i.strg.Promotion().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeletePromotion->Promotion->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *PromotionService) EvaluateBasket(ctx context.Context, req *product_service.EvaluateBasketRequest) (resp *product_service.EvaluateBasketResponse, err error) {
+
+	i.log.Info("---EvaluateBasket------>", logger.Any("req", req))
+
+	at := time.Now()
+	if len(req.GetAt()) > 0 {
+		at, err = time.Parse(config.DatabaseTimeLayout, req.At)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "at must be an RFC3339 timestamp")
+		}
+	}
+
+	var (
+		lines []promotion.Line
+		rates map[string]float64
+	)
+	for _, line := range req.GetLines() {
+		if line.GetQuantity() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+		}
+
+		product, err := i.strg.Product().GetByID(ctx, &product_service.ProductPK{Id: line.ProductId})
+		if err != nil {
+			i.log.Error("!!!EvaluateBasket->Product->Get--->", logger.Error(err))
+			return nil, status.Error(codes.NotFound, "product not found: "+line.ProductId)
+		}
+
+		// the basket is evaluated in the base currency, promotion amounts are kept in it
+		unitPrice := float64(product.Price)
+		if len(product.Currency) > 0 && product.Currency != i.cfg.BaseCurrency {
+			if rates == nil {
+				rates, err = i.strg.ExchangeRate().GetRates(ctx, at)
+				if err != nil {
+					i.log.Error("!!!EvaluateBasket->ExchangeRate->GetRates--->", logger.Error(err))
+					return nil, status.Error(codes.InvalidArgument, err.Error())
+				}
+			}
+
+			rate, ok := rates[product.Currency]
+			if !ok {
+				return nil, status.Errorf(codes.FailedPrecondition, config.ErrNoExchangeRate, product.Currency)
+			}
+
+			step, ok := i.cfg.CurrencyRounding[i.cfg.BaseCurrency]
+			if !ok {
+				step = config.DefaultCurrencyRounding
+			}
+			unitPrice = helper.RoundTo(unitPrice*rate, step)
+		}
+
+		lines = append(lines, promotion.Line{
+			ProductId:  product.Id,
+			CategoryId: product.CategoryId,
+			UnitPrice:  unitPrice,
+			Quantity:   line.Quantity,
+		})
+	}
+
+	promotions, err := i.strg.Promotion().GetActive(ctx, at)
+	if err != nil {
+		i.log.Error("!!!EvaluateBasket->Promotion->GetActive--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var rules []promotion.Rule
+	for _, p := range promotions {
+		rules = append(rules, toPromotionRule(p))
+	}
+
+	result := promotion.Evaluate(lines, rules, req.GetFilialId(), at)
+
+	resp = &product_service.EvaluateBasketResponse{
+		BaseTotal:     float32(result.BaseTotal),
+		DiscountTotal: float32(result.DiscountTotal),
+		Total:         float32(result.Total),
+		Currency:      i.cfg.BaseCurrency,
+	}
+
+	for _, line := range result.Lines {
+		resp.Lines = append(resp.Lines, &product_service.EvaluatedLine{
+			ProductId:    line.ProductId,
+			Quantity:     line.Quantity,
+			UnitPrice:    float32(line.UnitPrice),
+			BaseTotal:    float32(line.BaseTotal),
+			Discount:     float32(line.Discount),
+			FinalTotal:   float32(line.FinalTotal),
+			PromotionIds: line.PromotionIds,
+		})
+	}
+
+	for _, applied := range result.Applied {
+		resp.AppliedPromotions = append(resp.AppliedPromotions, &product_service.AppliedPromotion{
+			PromotionId: applied.PromotionId,
+			Name:        applied.Name,
+			Discount:    float32(applied.Discount),
+		})
+	}
+
+	return
+}
+
+func toPromotionRule(p *product_service.Promotion) promotion.Rule {
+	condition := p.GetCondition()
+	action := p.GetAction()
+
+	return promotion.Rule{
+		Id:          p.GetId(),
+		Name:        p.GetName(),
+		Priority:    p.GetPriority(),
+		ProductIds:  condition.GetProductIds(),
+		CategoryIds: condition.GetCategoryIds(),
+		FilialIds:   condition.GetFilialIds(),
+		MinQuantity: condition.GetMinQuantity(),
+		DailyFrom:   condition.GetDailyFrom(),
+		DailyTo:     condition.GetDailyTo(),
+		Type:        action.GetType(),
+		Percent:     float64(action.GetPercent()),
+		Amount:      float64(action.GetAmount()),
+		BuyQuantity: action.GetBuyQuantity(),
+		GetQuantity: action.GetGetQuantity(),
+		BundlePrice: float64(action.GetBundlePrice()),
+	}
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product_service/config"
+	"product_service/genproto/organization_service"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/logger"
+	"product_service/storage"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// quantityTolerance absorbs the rounding of summed fractional quantities when they are compared
+const quantityTolerance = 1e-6
+
+type PurchaseOrderService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	products *ProductService
+	*product_service.UnimplementedPurchaseOrderServiceServer
+}
+
+func NewPurchaseOrderService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *PurchaseOrderService {
+	return &PurchaseOrderService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+		products: NewProductService(cfg, log, strg, srvs),
+	}
+}
+
+func (i *PurchaseOrderService) Create(ctx context.Context, req *product_service.CreatePurchaseOrder) (resp *product_service.PurchaseOrder, err error) {
+
+	i.log.Info("---CreatePurchaseOrder------>", logger.Any("req", req))
+
+	if len(req.GetProviderId()) == 0 || len(req.GetMagazinId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "provider_id and magazin_id are required")
+	}
+
+	if len(req.GetExpectedDate()) > 0 {
+		if _, err = time.Parse("2006-01-02", req.GetExpectedDate()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "expected_date must be in YYYY-MM-DD format")
+		}
+	}
+
+	err = validateOrderLines(req.GetLines())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, line := range req.GetLines() {
+		if line.GetCostPrice() > 0 && !canSeeCost(ctx) {
+			return nil, status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+		}
+	}
+
+	if len(req.GetCurrency()) == 0 {
+		req.Currency = i.cfg.BaseCurrency
+	}
+	if err = i.products.checkCurrency(ctx, req.Currency); err != nil {
+		return nil, err
+	}
+
+	_, err = i.services.ProviderService().GetByID(ctx, &organization_service.ProviderPK{Id: req.GetProviderId()})
+	if err != nil {
+		i.log.Error("!!!CreatePurchaseOrder->ProviderService->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = i.services.MagazinService().GetByID(ctx, &organization_service.MagazinPK{Id: req.GetMagazinId()})
+	if err != nil {
+		i.log.Error("!!!CreatePurchaseOrder->MagazinService->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	req.StaffId = staffId(ctx, req.GetStaffId())
+
+	pKey, err := i.strg.PurchaseOrder().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreatePurchaseOrder->PurchaseOrder->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return i.GetByID(ctx, pKey)
+}
+
+func (i *PurchaseOrderService) GetByID(ctx context.Context, req *product_service.PurchaseOrderPK) (resp *product_service.PurchaseOrder, err error) {
+
+	i.log.Info("---GetPurchaseOrderByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.PurchaseOrder().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPurchaseOrderByID->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	hideOrderCost(ctx, resp)
+
+	return
+}
+
+func (i *PurchaseOrderService) GetList(ctx context.Context, req *product_service.GetListPurchaseOrderRequest) (resp *product_service.GetListPurchaseOrderResponse, err error) {
+
+	i.log.Info("---GetPurchaseOrders------>", logger.Any("req", req))
+
+	resp, err = i.strg.PurchaseOrder().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetPurchaseOrders->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hideOrderCost(ctx, resp.Orders...)
+
+	return
+}
+
+func (i *PurchaseOrderService) Send(ctx context.Context, req *product_service.PurchaseOrderPK) (resp *product_service.PurchaseOrder, err error) {
+
+	i.log.Info("---SendPurchaseOrder------>", logger.Any("req", req))
+
+	rowsAffected, err := i.strg.PurchaseOrder().Send(ctx, req)
+	if err != nil {
+		i.log.Error("!!!SendPurchaseOrder->PurchaseOrder->Send--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "order does not exist or is not a draft")
+	}
+
+	return i.GetByID(ctx, req)
+}
+
+func (i *PurchaseOrderService) Cancel(ctx context.Context, req *product_service.PurchaseOrderPK) (resp *product_service.PurchaseOrder, err error) {
+
+	i.log.Info("---CancelPurchaseOrder------>", logger.Any("req", req))
+
+	rowsAffected, err := i.strg.PurchaseOrder().Cancel(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CancelPurchaseOrder->PurchaseOrder->Cancel--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "order does not exist or is already received or cancelled")
+	}
+
+	return i.GetByID(ctx, req)
+}
+
+// Receive posts the delivered goods against a sent order and reports how everything received so far
+// compares with the order. Lines without a cost take the cost the order was placed at
+func (i *PurchaseOrderService) Receive(ctx context.Context, req *product_service.ReceivePurchaseOrderRequest) (resp *product_service.PurchaseOrderReconciliation, err error) {
+
+	i.log.Info("---ReceivePurchaseOrder------>", logger.Any("req", req))
+
+	err = validateReceiptLines(req.GetLines())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, line := range req.GetLines() {
+		if line.GetCostPrice() > 0 && !canSeeCost(ctx) {
+			return nil, status.Error(codes.PermissionDenied, config.ErrCostAccessDenied)
+		}
+	}
+
+	order, err := i.strg.PurchaseOrder().GetByID(ctx, &product_service.PurchaseOrderPK{Id: req.GetId()})
+	if err != nil {
+		i.log.Error("!!!ReceivePurchaseOrder->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	orderCosts := make(map[string]float32, len(order.GetLines()))
+	for _, line := range order.GetLines() {
+		orderCosts[line.GetProductId()] = line.GetCostPrice()
+	}
+	for _, line := range req.GetLines() {
+		if line.GetCostPrice() == 0 {
+			line.CostPrice = orderCosts[line.GetProductId()]
+		}
+	}
+
+	req.StaffId = staffId(ctx, req.GetStaffId())
+
+	receiptId, err := i.strg.PurchaseOrder().Receive(ctx, req)
+	if err != nil {
+		i.log.Error("!!!ReceivePurchaseOrder->PurchaseOrder->Receive--->", logger.Error(err))
+		return nil, stockError(err)
+	}
+
+	if len(receiptId) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "order is not awaiting goods")
+	}
+
+	resp, err = i.Reconcile(ctx, &product_service.PurchaseOrderPK{Id: req.GetId()})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.ReceiptId = receiptId
+
+	return resp, nil
+}
+
+// Reconcile reports the shortages and overages of everything received against the order
+func (i *PurchaseOrderService) Reconcile(ctx context.Context, req *product_service.PurchaseOrderPK) (resp *product_service.PurchaseOrderReconciliation, err error) {
+
+	i.log.Info("---ReconcilePurchaseOrder------>", logger.Any("req", req))
+
+	order, err := i.strg.PurchaseOrder().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!ReconcilePurchaseOrder->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	lines, err := i.strg.PurchaseOrder().Reconcile(ctx, req)
+	if err != nil {
+		i.log.Error("!!!ReconcilePurchaseOrder->PurchaseOrder->Reconcile--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp = &product_service.PurchaseOrderReconciliation{
+		OrderId: order.GetId(),
+		Status:  order.GetStatus(),
+		Lines:   lines,
+	}
+
+	for _, line := range lines {
+		line.Difference = line.Received - line.Ordered
+
+		switch {
+		case line.Difference < -quantityTolerance:
+			line.Status = config.ReconciliationShortage
+			resp.Shortages++
+		case line.Difference > quantityTolerance:
+			line.Status = config.ReconciliationOverage
+			resp.Overages++
+		default:
+			line.Difference = 0
+			line.Status = config.ReconciliationMatched
+		}
+	}
+
+	return resp, nil
+}
+
+func validateOrderLines(lines []*product_service.PurchaseOrderLine) error {
+	if len(lines) == 0 {
+		return errors.New("lines are required")
+	}
+
+	products := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		if len(line.GetProductId()) == 0 {
+			return errors.New("product_id is required")
+		}
+		if products[line.GetProductId()] {
+			return errors.New("product is ordered twice: " + line.GetProductId())
+		}
+		products[line.GetProductId()] = true
+
+		if line.GetQuantity() <= 0 {
+			return errors.New("quantity must be greater than zero")
+		}
+		if line.GetCostPrice() < 0 {
+			return errors.New("cost_price must not be negative")
+		}
+	}
+
+	return nil
+}
+
+// hideOrderCost clears the line costs and the totals unless the caller may see cost data
+func hideOrderCost(ctx context.Context, orders ...*product_service.PurchaseOrder) {
+	if canSeeCost(ctx) {
+		return
+	}
+
+	for _, order := range orders {
+		order.Total = 0
+		for _, line := range order.Lines {
+			line.CostPrice = 0
+		}
+	}
+}
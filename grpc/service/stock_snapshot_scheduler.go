@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/pkg/logger"
+	"product_service/storage"
+	"time"
+)
+
+// StockSnapshotScheduler periodically snapshots the stock balances so reads only add up the latest movements
+type StockSnapshotScheduler struct {
+	cfg  config.Config
+	log  logger.LoggerI
+	strg storage.StorageI
+}
+
+func NewStockSnapshotScheduler(cfg config.Config, log logger.LoggerI, strg storage.StorageI) *StockSnapshotScheduler {
+	return &StockSnapshotScheduler{
+		cfg:  cfg,
+		log:  log,
+		strg: strg,
+	}
+}
+
+func (s *StockSnapshotScheduler) Run(ctx context.Context) {
+
+	ticker := time.NewTicker(s.cfg.StockSnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		taken, err := s.strg.Stock().TakeSnapshots(ctx)
+		if err != nil {
+			s.log.Error("!!!StockSnapshotScheduler->Stock->TakeSnapshots--->", logger.Error(err))
+		} else if taken > 0 {
+			s.log.Info("---StockSnapshotScheduler------>", logger.Any("taken", taken))
+		}
+	}
+}
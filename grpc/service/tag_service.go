@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/logger"
+	"product_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TagService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*product_service.UnimplementedTagServiceServer
+}
+
+func NewTagService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *TagService {
+	return &TagService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *TagService) Create(ctx context.Context, req *product_service.CreateTag) (resp *product_service.Tag, err error) {
+
+	i.log.Info("---CreateTag------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	pKey, err := i.strg.Tag().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreateTag->Tag->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.Tag().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyTag->Tag->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *TagService) GetByID(ctx context.Context, req *product_service.TagPK) (resp *product_service.Tag, err error) {
+
+	i.log.Info("---GetTagByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.Tag().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetTagByID->Tag->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *TagService) GetList(ctx context.Context, req *product_service.GetListTagRequest) (resp *product_service.GetListTagResponse, err error) {
+
+	i.log.Info("---GetTags------>", logger.Any("req", req))
+
+	resp, err = i.strg.Tag().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetTags->Tag->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *TagService) Update(ctx context.Context, req *product_service.UpdateTag) (resp *product_service.Tag, err error) {
+
+	i.log.Info("---UpdateTag------>", logger.Any("req", req))
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	rowsAffected, err := i.strg.Tag().Update(ctx, req)
+
+	if err != nil {
+		i.log.Error("!!!UpdateTag--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.Tag().GetByID(ctx, &product_service.TagPK{Id: req.Id})
+	if err != nil {
+		i.log.Error("!!!GetTag->Tag->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
+func (i *TagService) Delete(ctx context.Context, req *product_service.TagPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteTag------>", logger.Any("req", req))
+
+	err = i.strg.Tag().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteTag->Tag->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
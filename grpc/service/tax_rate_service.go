@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/logger"
+	"product_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TaxRateService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*product_service.UnimplementedTaxRateServiceServer
+}
+
+func NewTaxRateService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *TaxRateService {
+	return &TaxRateService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *TaxRateService) Create(ctx context.Context, req *product_service.CreateTaxRate) (resp *product_service.TaxRate, err error) {
+
+	i.log.Info("---CreateTaxRate------>", logger.Any("req", req))
+
+	if req.GetRate() < 0 || req.GetRate() >= 100 {
+		return nil, status.Error(codes.InvalidArgument, "rate must be in [0, 100)")
+	}
+
+	pKey, err := i.strg.TaxRate().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreateTaxRate->TaxRate->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.TaxRate().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyTaxRate->TaxRate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *TaxRateService) GetByID(ctx context.Context, req *product_service.TaxRatePK) (resp *product_service.TaxRate, err error) {
+
+	i.log.Info("---GetTaxRateByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.TaxRate().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetTaxRateByID->TaxRate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *TaxRateService) GetList(ctx context.Context, req *product_service.GetListTaxRateRequest) (resp *product_service.GetListTaxRateResponse, err error) {
+
+	i.log.Info("---GetTaxRates------>", logger.Any("req", req))
+
+	resp, err = i.strg.TaxRate().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetTaxRates->TaxRate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *TaxRateService) Update(ctx context.Context, req *product_service.UpdateTaxRate) (resp *product_service.TaxRate, err error) {
+
+	i.log.Info("---UpdateTaxRate------>", logger.Any("req", req))
+
+	if req.GetRate() < 0 || req.GetRate() >= 100 {
+		return nil, status.Error(codes.InvalidArgument, "rate must be in [0, 100)")
+	}
+
+	rowsAffected, err := i.strg.TaxRate().Update(ctx, req)
+
+	if err != nil {
+		i.log.Error("!!!UpdateTaxRate--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.TaxRate().GetByID(ctx, &product_service.TaxRatePK{Id: req.Id})
+	if err != nil {
+		i.log.Error("!!!GetTaxRate->TaxRate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
+func (i *TaxRateService) Delete(ctx context.Context, req *product_service.TaxRatePK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteTaxRate------>", logger.Any("req", req))
+
+	err = i.strg.TaxRate().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteTaxRate->TaxRate->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/grpc/client"
+	"product_service/pkg/logger"
+	"product_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UnitService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*product_service.UnimplementedUnitServiceServer
+}
+
+func NewUnitService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *UnitService {
+	return &UnitService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *UnitService) Create(ctx context.Context, req *product_service.CreateUnit) (resp *product_service.Unit, err error) {
+
+	i.log.Info("---CreateUnit------>", logger.Any("req", req))
+
+	err = validateUnit(req.GetCode(), req.GetDecimals())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pKey, err := i.strg.Unit().Create(ctx, req)
+	if err != nil {
+		i.log.Error("!!!CreateUnit->Unit->Create--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err = i.strg.Unit().GetByID(ctx, pKey)
+	if err != nil {
+		i.log.Error("!!!GetByPKeyUnit->Unit->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *UnitService) GetByID(ctx context.Context, req *product_service.UnitPK) (resp *product_service.Unit, err error) {
+
+	i.log.Info("---GetUnitByID------>", logger.Any("req", req))
+
+	resp, err = i.strg.Unit().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetUnitByID->Unit->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *UnitService) GetList(ctx context.Context, req *product_service.GetListUnitRequest) (resp *product_service.GetListUnitResponse, err error) {
+
+	i.log.Info("---GetUnits------>", logger.Any("req", req))
+
+	resp, err = i.strg.Unit().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("!!!GetUnits->Unit->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return
+}
+
+func (i *UnitService) Update(ctx context.Context, req *product_service.UpdateUnit) (resp *product_service.Unit, err error) {
+
+	i.log.Info("---UpdateUnit------>", logger.Any("req", req))
+
+	err = validateUnit(req.GetCode(), req.GetDecimals())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rowsAffected, err := i.strg.Unit().Update(ctx, req)
+
+	if err != nil {
+		i.log.Error("!!!UpdateUnit--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.Unit().GetByID(ctx, &product_service.UnitPK{Id: req.Id})
+	if err != nil {
+		i.log.Error("!!!GetUnit->Unit->Get--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return resp, err
+}
+
+func (i *UnitService) Delete(ctx context.Context, req *product_service.UnitPK) (resp *empty.Empty, err error) {
+
+	i.log.Info("---DeleteUnit------>", logger.Any("req", req))
+
+	err = i.strg.Unit().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("!!!DeleteUnit->Unit->Get--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func validateUnit(code string, decimals int32) error {
+	if len(code) == 0 {
+		return errors.New("code is required")
+	}
+	if decimals < 0 || decimals > 6 {
+		return errors.New("decimals must be between 0 and 6")
+	}
+	return nil
+}
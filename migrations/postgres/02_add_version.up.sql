@@ -0,0 +1,3 @@
+ALTER TABLE "category" ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
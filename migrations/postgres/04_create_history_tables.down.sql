@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS product_system_versioning ON "product";
+DROP TRIGGER IF EXISTS category_system_versioning ON "category";
+DROP FUNCTION IF EXISTS system_versioning();
+DROP TABLE IF EXISTS "product_history";
+DROP TABLE IF EXISTS "category_history";
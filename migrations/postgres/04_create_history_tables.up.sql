@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS "category_history"(
+    id UUID NOT NULL,
+    data JSONB NOT NULL,
+    valid_from TIMESTAMP NOT NULL,
+    valid_to TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS category_history_id_idx ON "category_history" (id, valid_from);
+
+CREATE TABLE IF NOT EXISTS "product_history"(
+    id UUID NOT NULL,
+    data JSONB NOT NULL,
+    valid_from TIMESTAMP NOT NULL,
+    valid_to TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS product_history_id_idx ON "product_history" (id, valid_from);
+
+-- keeps <table>_history in sync: closes the current version and opens a new one with the row snapshot
+CREATE OR REPLACE FUNCTION system_versioning() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        EXECUTE format('UPDATE %I SET valid_to = NOW() WHERE id = $1 AND valid_to IS NULL', TG_TABLE_NAME || '_history')
+        USING OLD.id;
+    END IF;
+
+    IF TG_OP <> 'DELETE' THEN
+        EXECUTE format('INSERT INTO %I (id, data, valid_from) VALUES ($1, $2, NOW())', TG_TABLE_NAME || '_history')
+        USING NEW.id, to_jsonb(NEW);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+INSERT INTO "category_history" (id, data, valid_from)
+SELECT id, to_jsonb(c), COALESCE(created_at, NOW()) FROM "category" c;
+
+INSERT INTO "product_history" (id, data, valid_from)
+SELECT id, to_jsonb(p), COALESCE(created_at, NOW()) FROM "product" p;
+
+CREATE TRIGGER category_system_versioning
+    AFTER INSERT OR UPDATE OR DELETE ON "category"
+    FOR EACH ROW EXECUTE PROCEDURE system_versioning();
+
+CREATE TRIGGER product_system_versioning
+    AFTER INSERT OR UPDATE OR DELETE ON "product"
+    FOR EACH ROW EXECUTE PROCEDURE system_versioning();
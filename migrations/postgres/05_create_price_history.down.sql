@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS product_price_history ON "product";
+DROP FUNCTION IF EXISTS product_price_history();
+DROP TABLE IF EXISTS "scheduled_price_change";
+DROP TABLE IF EXISTS "product_price_history";
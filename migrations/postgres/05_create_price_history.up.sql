@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS "product_price_history"(
+    id BIGSERIAL PRIMARY KEY,
+    product_id UUID NOT NULL,
+    old_price DOUBLE PRECISION,
+    new_price DOUBLE PRECISION NOT NULL,
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS product_price_history_product_idx ON "product_price_history" (product_id, changed_at);
+
+CREATE TABLE IF NOT EXISTS "scheduled_price_change"(
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    effective_from TIMESTAMP NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    applied_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS scheduled_price_change_due_idx ON "scheduled_price_change" (status, effective_from);
+
+CREATE OR REPLACE FUNCTION product_price_history() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO "product_price_history" (product_id, old_price, new_price) VALUES (NEW.id, NULL, NEW.price);
+    ELSIF OLD.price IS DISTINCT FROM NEW.price THEN
+        INSERT INTO "product_price_history" (product_id, old_price, new_price) VALUES (NEW.id, OLD.price, NEW.price);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+INSERT INTO "product_price_history" (product_id, old_price, new_price, changed_at)
+SELECT id, NULL, price, COALESCE(created_at, NOW()) FROM "product";
+
+CREATE TRIGGER product_price_history
+    AFTER INSERT OR UPDATE OF price ON "product"
+    FOR EACH ROW EXECUTE PROCEDURE product_price_history();
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS "promotion"(
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    product_ids UUID[],
+    category_ids UUID[],
+    filial_ids UUID[],
+    min_quantity DOUBLE PRECISION NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP,
+    ends_at TIMESTAMP,
+    daily_from VARCHAR(5),
+    daily_to VARCHAR(5),
+    type VARCHAR(30) NOT NULL,
+    percent DOUBLE PRECISION NOT NULL DEFAULT 0,
+    amount DOUBLE PRECISION NOT NULL DEFAULT 0,
+    buy_quantity DOUBLE PRECISION NOT NULL DEFAULT 0,
+    get_quantity DOUBLE PRECISION NOT NULL DEFAULT 0,
+    bundle_price DOUBLE PRECISION NOT NULL DEFAULT 0,
+    priority INT NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS promotion_active_idx ON "promotion" (active, starts_at, ends_at);
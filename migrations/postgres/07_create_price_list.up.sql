@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS "price_list"(
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS price_list_default_idx ON "price_list" (is_default) WHERE is_default;
+
+-- the default retail list has no items of its own until quantity breaks are added, product.price is its base tier
+INSERT INTO "price_list" (id, name, code, is_default, created_at, updated_at)
+VALUES ('00000000-0000-0000-0000-000000000001', 'Retail', 'retail', TRUE, NOW(), NOW())
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS "price_list_item"(
+    id UUID PRIMARY KEY,
+    price_list_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    min_quantity DOUBLE PRECISION NOT NULL DEFAULT 1,
+    price DOUBLE PRECISION NOT NULL,
+    valid_from TIMESTAMP,
+    valid_to TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    FOREIGN KEY (price_list_id) REFERENCES price_list (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS price_list_item_product_idx ON "price_list_item" (product_id, price_list_id, min_quantity);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "price_override"(
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL,
+    filial_id UUID,
+    magazin_id UUID,
+    price DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK ((filial_id IS NULL) <> (magazin_id IS NULL)),
+    UNIQUE (product_id, filial_id),
+    UNIQUE (product_id, magazin_id),
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "product_provider_cost";
+
+ALTER TABLE "product" DROP COLUMN IF EXISTS cost_price;
@@ -0,0 +1,12 @@
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS cost_price DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "product_provider_cost"(
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL,
+    provider_id UUID NOT NULL,
+    cost_price DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    UNIQUE (product_id, provider_id),
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,8 @@
+ALTER TABLE "product" DROP COLUMN IF EXISTS price_excludes_vat;
+ALTER TABLE "product" DROP COLUMN IF EXISTS package_code;
+ALTER TABLE "product" DROP COLUMN IF EXISTS mxik_code;
+ALTER TABLE "product" DROP COLUMN IF EXISTS tax_rate_id;
+
+ALTER TABLE "category" DROP COLUMN IF EXISTS tax_rate_id;
+
+DROP TABLE IF EXISTS "tax_rate";
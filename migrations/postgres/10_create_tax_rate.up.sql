@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "tax_rate"(
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    rate DOUBLE PRECISION NOT NULL CHECK (rate >= 0 AND rate < 100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE "category" ADD COLUMN IF NOT EXISTS tax_rate_id UUID REFERENCES tax_rate (id) ON DELETE SET NULL;
+
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS tax_rate_id UUID REFERENCES tax_rate (id) ON DELETE SET NULL;
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS mxik_code VARCHAR(17);
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS package_code VARCHAR(20);
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS price_excludes_vat BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,3 @@
+ALTER TABLE "product" DROP COLUMN IF EXISTS requires_marking;
+
+ALTER TABLE "category" DROP COLUMN IF EXISTS requires_marking;
@@ -0,0 +1,3 @@
+ALTER TABLE "category" ADD COLUMN IF NOT EXISTS requires_marking BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS requires_marking BOOLEAN NOT NULL DEFAULT FALSE;
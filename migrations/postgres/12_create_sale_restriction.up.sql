@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS "sale_restriction"(
+    id UUID PRIMARY KEY,
+    product_id UUID,
+    category_id UUID,
+    filial_id UUID,
+    min_age INT NOT NULL DEFAULT 0,
+    sale_from VARCHAR(5),
+    sale_to VARCHAR(5),
+    max_quantity DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK ((product_id IS NULL) <> (category_id IS NULL)),
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES category (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS sale_restriction_product_idx ON "sale_restriction" (product_id);
+CREATE INDEX IF NOT EXISTS sale_restriction_category_idx ON "sale_restriction" (category_id);
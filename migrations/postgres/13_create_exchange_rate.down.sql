@@ -0,0 +1,8 @@
+ALTER TABLE "product_provider_cost" DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE "product" DROP COLUMN IF EXISTS cost_currency;
+ALTER TABLE "product" DROP COLUMN IF EXISTS currency;
+
+DROP FUNCTION IF EXISTS currency_rate(VARCHAR, TIMESTAMP);
+
+DROP TABLE IF EXISTS "exchange_rate";
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "exchange_rate"(
+    id UUID PRIMARY KEY,
+    currency VARCHAR(3) NOT NULL,
+    rate DOUBLE PRECISION NOT NULL CHECK (rate > 0),
+    effective_from TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (currency, effective_from)
+);
+
+-- the base currency converts to itself one to one
+INSERT INTO "exchange_rate" (id, currency, rate, effective_from)
+VALUES ('00000000-0000-0000-0000-000000000001', 'UZS', 1, '1970-01-01')
+ON CONFLICT DO NOTHING;
+
+CREATE OR REPLACE FUNCTION currency_rate(code VARCHAR, at TIMESTAMP) RETURNS DOUBLE PRECISION AS $$
+    SELECT rate FROM "exchange_rate"
+    WHERE currency = code AND effective_from <= at
+    ORDER BY effective_from DESC
+    LIMIT 1
+$$ LANGUAGE SQL STABLE;
+
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'UZS';
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS cost_currency VARCHAR(3) NOT NULL DEFAULT 'UZS';
+
+ALTER TABLE "product_provider_cost" ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'UZS';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "product_packaging";
+
+ALTER TABLE "product" DROP COLUMN IF EXISTS unit_id;
+
+DROP TABLE IF EXISTS "unit";
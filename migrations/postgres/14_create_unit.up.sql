@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS "unit"(
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    code VARCHAR(20) NOT NULL UNIQUE,
+    decimals INT NOT NULL DEFAULT 0 CHECK (decimals >= 0 AND decimals <= 6),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+INSERT INTO "unit" (id, name, code, decimals, created_at, updated_at) VALUES
+    ('00000000-0000-0000-0000-000000000001', 'Piece', 'pcs', 0, NOW(), NOW()),
+    ('00000000-0000-0000-0000-000000000002', 'Kilogram', 'kg', 3, NOW(), NOW()),
+    ('00000000-0000-0000-0000-000000000003', 'Liter', 'l', 3, NOW(), NOW()),
+    ('00000000-0000-0000-0000-000000000004', 'Meter', 'm', 2, NOW(), NOW())
+ON CONFLICT DO NOTHING;
+
+-- products created before units existed are sold by piece
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS unit_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES unit (id);
+
+CREATE TABLE IF NOT EXISTS "product_packaging"(
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    barcode VARCHAR(50) UNIQUE,
+    parent_id UUID REFERENCES product_packaging (id) ON DELETE RESTRICT,
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity > 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK (parent_id IS NULL OR parent_id <> id),
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS product_packaging_product_idx ON "product_packaging" (product_id);
@@ -0,0 +1,6 @@
+ALTER TABLE "product" DROP COLUMN IF EXISTS manufacturer_id;
+ALTER TABLE "product" DROP COLUMN IF EXISTS brand_id;
+
+DROP TABLE IF EXISTS "brand";
+
+DROP TABLE IF EXISTS "manufacturer";
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "manufacturer"(
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    country VARCHAR(100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS "brand"(
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    manufacturer_id UUID REFERENCES manufacturer (id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS brand_id UUID REFERENCES brand (id) ON DELETE SET NULL;
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS manufacturer_id UUID REFERENCES manufacturer (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS product_brand_idx ON "product" (brand_id);
+CREATE INDEX IF NOT EXISTS product_manufacturer_idx ON "product" (manufacturer_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "product_tag";
+
+DROP TABLE IF EXISTS "tag";
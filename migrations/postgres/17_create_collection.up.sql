@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS "collection"(
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    rule JSONB NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
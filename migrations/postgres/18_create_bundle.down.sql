@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "bundle_component";
+
+ALTER TABLE "product" DROP COLUMN IF EXISTS bundle_discount;
+ALTER TABLE "product" DROP COLUMN IF EXISTS bundle_pricing;
+ALTER TABLE "product" DROP COLUMN IF EXISTS is_bundle;
+ALTER TABLE "product" DROP COLUMN IF EXISTS active;
@@ -0,0 +1,17 @@
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS active BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS is_bundle BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS bundle_pricing VARCHAR(30);
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS bundle_discount DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "bundle_component"(
+    bundle_id UUID NOT NULL,
+    component_id UUID NOT NULL,
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity > 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (bundle_id, component_id),
+    CHECK (bundle_id <> component_id),
+    FOREIGN KEY (bundle_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (component_id) REFERENCES product (id) ON DELETE RESTRICT ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS bundle_component_component_idx ON "bundle_component" (component_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "product_relation"(
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL,
+    related_id UUID NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('substitute', 'accessory', 'upsell', 'cross_sell')),
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    UNIQUE (product_id, related_id, type),
+    CHECK (product_id <> related_id),
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (related_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS product_relation_related_idx ON "product_relation" (related_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "product_template";
+
+-- barcode stays VARCHAR(50): supplied barcodes longer than the generated 9 characters would not fit back
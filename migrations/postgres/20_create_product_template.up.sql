@@ -0,0 +1,11 @@
+ALTER TABLE "product" ALTER COLUMN barcode TYPE VARCHAR(50);
+
+CREATE TABLE IF NOT EXISTS "product_template"(
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    category_id UUID UNIQUE,
+    defaults JSONB NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    FOREIGN KEY (category_id) REFERENCES category (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "product_redirect";
+
+DROP INDEX IF EXISTS product_barcode_idx;
+DROP INDEX IF EXISTS product_name_trgm_idx;
@@ -0,0 +1,16 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS product_name_trgm_idx ON "product" USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS product_barcode_idx ON "product" (barcode);
+
+-- merged products are deleted, their ids and barcodes keep resolving to the product they were merged into
+CREATE TABLE IF NOT EXISTS "product_redirect"(
+    from_id UUID PRIMARY KEY,
+    barcode VARCHAR(50),
+    to_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (to_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS product_redirect_barcode_idx ON "product_redirect" (barcode);
+CREATE INDEX IF NOT EXISTS product_redirect_to_idx ON "product_redirect" (to_id);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS product_temperature_class_idx;
+
+ALTER TABLE "product" DROP CONSTRAINT IF EXISTS product_gross_weight_net_check;
+
+ALTER TABLE "product" DROP COLUMN IF EXISTS temperature_class;
+ALTER TABLE "product" DROP COLUMN IF EXISTS dimension_unit;
+ALTER TABLE "product" DROP COLUMN IF EXISTS height;
+ALTER TABLE "product" DROP COLUMN IF EXISTS width;
+ALTER TABLE "product" DROP COLUMN IF EXISTS length;
+ALTER TABLE "product" DROP COLUMN IF EXISTS weight_unit;
+ALTER TABLE "product" DROP COLUMN IF EXISTS gross_weight;
+ALTER TABLE "product" DROP COLUMN IF EXISTS net_weight;
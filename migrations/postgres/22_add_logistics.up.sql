@@ -0,0 +1,12 @@
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS net_weight DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (net_weight >= 0);
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS gross_weight DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (gross_weight >= 0);
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS weight_unit VARCHAR(5) NOT NULL DEFAULT 'kg' CHECK (weight_unit IN ('g', 'kg'));
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS length DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (length >= 0);
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS width DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (width >= 0);
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS height DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (height >= 0);
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS dimension_unit VARCHAR(5) NOT NULL DEFAULT 'cm' CHECK (dimension_unit IN ('mm', 'cm', 'm'));
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS temperature_class VARCHAR(20) CHECK (temperature_class IN ('ambient', 'chilled', 'frozen'));
+
+ALTER TABLE "product" ADD CONSTRAINT product_gross_weight_net_check CHECK (gross_weight = 0 OR gross_weight >= net_weight);
+
+CREATE INDEX IF NOT EXISTS product_temperature_class_idx ON "product" (temperature_class);
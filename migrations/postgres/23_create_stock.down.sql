@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "stock_setting";
+DROP TABLE IF EXISTS "stock";
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS "stock"(
+    product_id UUID NOT NULL,
+    magazin_id UUID NOT NULL,
+    quantity DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    PRIMARY KEY (product_id, magazin_id),
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS stock_magazin_idx ON "stock" (magazin_id);
+
+CREATE TABLE IF NOT EXISTS "stock_setting"(
+    magazin_id UUID PRIMARY KEY,
+    allow_negative BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
@@ -0,0 +1,10 @@
+ALTER TABLE "stock" ADD COLUMN IF NOT EXISTS quantity DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+UPDATE "stock" s SET quantity = l.quantity
+FROM "stock_level" l
+WHERE l.product_id = s.product_id AND l.magazin_id = s.magazin_id;
+
+DROP VIEW IF EXISTS "stock_level";
+DROP TABLE IF EXISTS "stock_snapshot";
+DROP TABLE IF EXISTS "stock_movement";
+DROP FUNCTION IF EXISTS stock_movement_immutable();
@@ -0,0 +1,60 @@
+CREATE TABLE IF NOT EXISTS "stock_movement"(
+    id BIGSERIAL PRIMARY KEY,
+    product_id UUID NOT NULL,
+    magazin_id UUID NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('receipt', 'sale', 'return', 'write_off', 'transfer', 'adjustment')),
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity <> 0),
+    document_id VARCHAR(64),
+    staff_id VARCHAR(64),
+    comment TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS stock_movement_stock_idx ON "stock_movement" (product_id, magazin_id, id);
+CREATE INDEX IF NOT EXISTS stock_movement_magazin_idx ON "stock_movement" (magazin_id, created_at);
+CREATE INDEX IF NOT EXISTS stock_movement_document_idx ON "stock_movement" (document_id);
+
+-- the ledger is append-only, corrections are new movements
+CREATE OR REPLACE FUNCTION stock_movement_immutable() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'stock movements cannot be changed or deleted';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_movement_immutable
+    BEFORE UPDATE OR DELETE ON "stock_movement"
+    FOR EACH ROW EXECUTE PROCEDURE stock_movement_immutable();
+
+-- the balance of every stock row up to last_movement_id, so reads only add up the movements after it
+CREATE TABLE IF NOT EXISTS "stock_snapshot"(
+    product_id UUID NOT NULL,
+    magazin_id UUID NOT NULL,
+    quantity DOUBLE PRECISION NOT NULL,
+    last_movement_id BIGINT NOT NULL,
+    taken_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (product_id, magazin_id),
+    FOREIGN KEY (product_id, magazin_id) REFERENCES stock (product_id, magazin_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- the existing balances become the opening movements of the ledger
+INSERT INTO "stock_movement" (product_id, magazin_id, type, quantity, comment, created_at)
+SELECT product_id, magazin_id, 'adjustment', quantity, 'opening balance', NOW()
+FROM "stock"
+WHERE quantity <> 0;
+
+ALTER TABLE "stock" DROP COLUMN IF EXISTS quantity;
+
+CREATE OR REPLACE VIEW "stock_level" AS
+SELECT
+    s.product_id,
+    s.magazin_id,
+    COALESCE(sn.quantity, 0) + COALESCE((
+        SELECT SUM(m.quantity)
+        FROM "stock_movement" m
+        WHERE m.product_id = s.product_id
+            AND m.magazin_id = s.magazin_id
+            AND m.id > COALESCE(sn.last_movement_id, 0)
+    ), 0) AS quantity,
+    s.updated_at
+FROM "stock" s
+LEFT JOIN "stock_snapshot" sn ON sn.product_id = s.product_id AND sn.magazin_id = s.magazin_id;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "goods_receipt_line";
+DROP TABLE IF EXISTS "goods_receipt";
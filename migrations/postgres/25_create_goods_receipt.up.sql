@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS "goods_receipt"(
+    id UUID PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    magazin_id UUID NOT NULL,
+    invoice_number VARCHAR(50),
+    status VARCHAR(10) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'posted')),
+    currency VARCHAR(3) NOT NULL DEFAULT 'UZS',
+    comment TEXT,
+    staff_id VARCHAR(64),
+    posted_by VARCHAR(64),
+    posted_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS goods_receipt_provider_idx ON "goods_receipt" (provider_id, created_at);
+CREATE INDEX IF NOT EXISTS goods_receipt_magazin_idx ON "goods_receipt" (magazin_id, created_at);
+
+CREATE TABLE IF NOT EXISTS "goods_receipt_line"(
+    id UUID PRIMARY KEY,
+    receipt_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity > 0),
+    cost_price DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (cost_price >= 0),
+    expiry_date DATE,
+    position INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY (receipt_id) REFERENCES goods_receipt (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE RESTRICT ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS goods_receipt_line_receipt_idx ON "goods_receipt_line" (receipt_id, position);
+CREATE INDEX IF NOT EXISTS goods_receipt_line_product_idx ON "goods_receipt_line" (product_id);
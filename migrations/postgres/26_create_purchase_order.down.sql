@@ -0,0 +1,3 @@
+ALTER TABLE "goods_receipt" DROP COLUMN IF EXISTS purchase_order_id;
+DROP TABLE IF EXISTS "purchase_order_line";
+DROP TABLE IF EXISTS "purchase_order";
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS "purchase_order"(
+    id UUID PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    magazin_id UUID NOT NULL,
+    expected_date DATE,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'sent', 'partially_received', 'received', 'cancelled')),
+    currency VARCHAR(3) NOT NULL DEFAULT 'UZS',
+    comment TEXT,
+    staff_id VARCHAR(64),
+    sent_at TIMESTAMP,
+    cancelled_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS purchase_order_provider_idx ON "purchase_order" (provider_id, created_at);
+CREATE INDEX IF NOT EXISTS purchase_order_magazin_idx ON "purchase_order" (magazin_id, status);
+
+CREATE TABLE IF NOT EXISTS "purchase_order_line"(
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity > 0),
+    cost_price DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (cost_price >= 0),
+    position INTEGER NOT NULL DEFAULT 0,
+    UNIQUE (order_id, product_id),
+    FOREIGN KEY (order_id) REFERENCES purchase_order (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES product (id) ON DELETE RESTRICT ON UPDATE CASCADE
+);
+
+ALTER TABLE "goods_receipt" ADD COLUMN IF NOT EXISTS purchase_order_id UUID REFERENCES purchase_order (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS goods_receipt_purchase_order_idx ON "goods_receipt" (purchase_order_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS product_gtin_idx;
+
+ALTER TABLE "product" DROP COLUMN IF EXISTS gtin;
@@ -0,0 +1,3 @@
+ALTER TABLE "product" ADD COLUMN IF NOT EXISTS gtin VARCHAR(14);
+
+CREATE UNIQUE INDEX IF NOT EXISTS product_gtin_idx ON "product" (gtin) WHERE gtin IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS category_audit_log ON "category";
+DROP TRIGGER IF EXISTS product_audit_log ON "product";
+DROP FUNCTION IF EXISTS audit_log_change();
@@ -0,0 +1,51 @@
+-- records every change of a product or a category in the audit log within the transaction making it,
+-- the actor, the request and the action come from the audit.* settings of the transaction
+CREATE OR REPLACE FUNCTION audit_log_change() RETURNS TRIGGER AS $$
+DECLARE
+    before_row JSONB;
+    after_row JSONB;
+    changes JSONB;
+    audit_action TEXT := NULLIF(current_setting('audit.action', true), '');
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        before_row := to_jsonb(OLD);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        after_row := to_jsonb(NEW);
+    END IF;
+
+    SELECT COALESCE(jsonb_object_agg(key, jsonb_build_object('before', before_row -> key, 'after', after_row -> key)), '{}')
+    INTO changes
+    FROM jsonb_object_keys(COALESCE(before_row, '{}') || COALESCE(after_row, '{}')) AS key
+    WHERE (before_row -> key) IS DISTINCT FROM (after_row -> key);
+
+    -- a bare version bump is not a change of its own unless the caller named the action
+    IF TG_OP = 'UPDATE' AND audit_action IS NULL AND (changes - 'version' - 'updated_at') = '{}' THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO "audit_log" (id, entity_type, entity_id, action, actor, request_id, before, after, diff, created_at)
+    VALUES (
+        md5(random()::text || clock_timestamp()::text)::uuid,
+        TG_ARGV[0],
+        (COALESCE(after_row, before_row) ->> 'id')::uuid,
+        COALESCE(audit_action, CASE TG_OP WHEN 'INSERT' THEN 'create' WHEN 'UPDATE' THEN 'update' ELSE 'delete' END),
+        COALESCE(current_setting('audit.actor', true), ''),
+        COALESCE(NULLIF(current_setting('audit.request_id', true), ''), md5(random()::text || clock_timestamp()::text)::uuid::text),
+        before_row,
+        after_row,
+        changes,
+        NOW()
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER product_audit_log
+    AFTER INSERT OR UPDATE OR DELETE ON "product"
+    FOR EACH ROW EXECUTE PROCEDURE audit_log_change('product');
+
+CREATE TRIGGER category_audit_log
+    AFTER INSERT OR UPDATE OR DELETE ON "category"
+    FOR EACH ROW EXECUTE PROCEDURE audit_log_change('category');
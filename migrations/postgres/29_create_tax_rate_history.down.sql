@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS tax_rate_system_versioning ON "tax_rate";
+DROP TABLE IF EXISTS "tax_rate_history";
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "tax_rate_history"(
+    id UUID NOT NULL,
+    data JSONB NOT NULL,
+    valid_from TIMESTAMP NOT NULL,
+    valid_to TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS tax_rate_history_id_idx ON "tax_rate_history" (id, valid_from);
+
+INSERT INTO "tax_rate_history" (id, data, valid_from)
+SELECT id, to_jsonb(t), COALESCE(created_at, NOW()) FROM "tax_rate" t;
+
+CREATE TRIGGER tax_rate_system_versioning
+    AFTER INSERT OR UPDATE OR DELETE ON "tax_rate"
+    FOR EACH ROW EXECUTE PROCEDURE system_versioning();
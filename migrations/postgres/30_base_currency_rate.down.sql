@@ -0,0 +1,16 @@
+ALTER TABLE "purchase_order" ALTER COLUMN currency SET DEFAULT 'UZS';
+ALTER TABLE "goods_receipt" ALTER COLUMN currency SET DEFAULT 'UZS';
+ALTER TABLE "product_provider_cost" ALTER COLUMN currency SET DEFAULT 'UZS';
+ALTER TABLE "product" ALTER COLUMN cost_currency SET DEFAULT 'UZS';
+ALTER TABLE "product" ALTER COLUMN currency SET DEFAULT 'UZS';
+
+INSERT INTO "exchange_rate" (id, currency, rate, effective_from)
+VALUES ('00000000-0000-0000-0000-000000000001', 'UZS', 1, '1970-01-01')
+ON CONFLICT DO NOTHING;
+
+CREATE OR REPLACE FUNCTION currency_rate(code VARCHAR, at TIMESTAMP) RETURNS DOUBLE PRECISION AS $$
+    SELECT rate FROM "exchange_rate"
+    WHERE currency = code AND effective_from <= at
+    ORDER BY effective_from DESC
+    LIMIT 1
+$$ LANGUAGE SQL STABLE;
@@ -0,0 +1,18 @@
+-- the base currency comes from the service, every connection sets it as currency.base,
+-- so the base converts to itself one to one whatever currency it is
+CREATE OR REPLACE FUNCTION currency_rate(code VARCHAR, at TIMESTAMP) RETURNS DOUBLE PRECISION AS $$
+    SELECT CASE WHEN code = current_setting('currency.base', true) THEN 1 ELSE (
+        SELECT rate FROM "exchange_rate"
+        WHERE currency = code AND effective_from <= at
+        ORDER BY effective_from DESC
+        LIMIT 1
+    ) END
+$$ LANGUAGE SQL STABLE;
+
+DELETE FROM "exchange_rate" WHERE id = '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE "product" ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE "product" ALTER COLUMN cost_currency DROP DEFAULT;
+ALTER TABLE "product_provider_cost" ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE "goods_receipt" ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE "purchase_order" ALTER COLUMN currency DROP DEFAULT;
@@ -0,0 +1,16 @@
+package models
+
+const (
+	AuditEntityProduct  = "product"
+	AuditEntityCategory = "category"
+
+	AuditActionCreate   = "create"
+	AuditActionUpdate   = "update"
+	AuditActionPatch    = "patch"
+	AuditActionDelete   = "delete"
+	AuditActionReprice  = "reprice"
+	AuditActionMerge    = "merge"
+	AuditActionBundle   = "bundle"
+	AuditActionReceipt  = "goods_receipt"
+	AuditActionSchedule = "scheduled_price"
+)
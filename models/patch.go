@@ -1,6 +1,7 @@
 package models
 
 type UpdatePatchRequest struct {
-	Id     string                 `json:"id"`
-	Fields map[string]interface{} `json:"fields"`
+	Id      string                 `json:"id"`
+	Version int64                  `json:"version"`
+	Fields  map[string]interface{} `json:"fields"`
 }
@@ -0,0 +1,14 @@
+package models
+
+import "fmt"
+
+// InsufficientStockError is returned when a stock adjustment would take a product below zero in a store that forbids it
+type InsufficientStockError struct {
+	ProductId string
+	Available float64
+	Requested float64
+}
+
+func (e *InsufficientStockError) Error() string {
+	return fmt.Sprintf("insufficient stock of product %s: %g available, %g requested", e.ProductId, e.Available, e.Requested)
+}
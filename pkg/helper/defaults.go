@@ -0,0 +1,22 @@
+package helper
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FillDefaults copies every populated field of defaults into msg where msg leaves it unset,
+// for proto3 scalars unset means the zero value
+func FillDefaults(msg, defaults proto.Message) {
+	if msg == nil || defaults == nil || !defaults.ProtoReflect().IsValid() {
+		return
+	}
+
+	dst := msg.ProtoReflect()
+	defaults.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !dst.Has(fd) {
+			dst.Set(fd, v)
+		}
+		return true
+	})
+}
@@ -0,0 +1,48 @@
+package helper
+
+import (
+	"errors"
+	"math"
+)
+
+// ValidateMxikCode checks that the code is a 17 digit MXIK (IKPU) classifier code
+func ValidateMxikCode(code string) error {
+	if len(code) != 17 || !isDigits(code) {
+		return errors.New("mxik code must be exactly 17 digits")
+	}
+	return nil
+}
+
+// ValidatePackageCode checks that the package code of the fiscal classifier is numeric and at most 20 digits
+func ValidatePackageCode(code string) error {
+	if len(code) == 0 || len(code) > 20 || !isDigits(code) {
+		return errors.New("package code must be up to 20 digits")
+	}
+	return nil
+}
+
+// SplitVat splits a price into net, vat and gross amounts, rate is in percent
+func SplitVat(price, rate float64, excludesVat bool) (net, vat, gross float64) {
+	if excludesVat {
+		net = price
+		gross = price * (1 + rate/100)
+	} else {
+		gross = price
+		net = price / (1 + rate/100)
+	}
+
+	net = math.Round(net*100) / 100
+	gross = math.Round(gross*100) / 100
+	vat = math.Round((gross-net)*100) / 100
+
+	return
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,11 +16,18 @@ func ReplaceQueryParams(namedQuery string, params map[string]interface{}) (strin
 		args []interface{}
 	)
 
-	for k, v := range params {
+	// longer names are replaced first so that :price does not eat into :price_excludes_vat
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(a, b int) bool { return len(keys[a]) > len(keys[b]) })
+
+	for _, k := range keys {
 		if k != "" {
 			namedQuery = strings.ReplaceAll(namedQuery, ":"+k, "$"+strconv.Itoa(i))
 
-			args = append(args, v)
+			args = append(args, params[k])
 			i++
 		}
 	}
@@ -0,0 +1,56 @@
+package helper
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReplaceQueryParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		params map[string]interface{}
+		want   string
+		args   []interface{}
+	}{
+		{
+			name:   "single param used twice",
+			query:  "WHERE a.id = :id OR b.id = :id",
+			params: map[string]interface{}{"id": "x"},
+			want:   "WHERE a.id = $1 OR b.id = $1",
+			args:   []interface{}{"x"},
+		},
+		{
+			name:   "name that prefixes a longer name",
+			query:  "SET price = :price, price_excludes_vat = :price_excludes_vat",
+			params: map[string]interface{}{"price": 100.0, "price_excludes_vat": true},
+			want:   "SET price = $2, price_excludes_vat = $1",
+			args:   []interface{}{true, 100.0},
+		},
+		{
+			name:   "type cast after the param",
+			query:  "WHERE changed_at <= :as_of::timestamp",
+			params: map[string]interface{}{"as_of": "2024-01-01"},
+			want:   "WHERE changed_at <= $1::timestamp",
+			args:   []interface{}{"2024-01-01"},
+		},
+		{
+			name:   "empty name is skipped",
+			query:  "WHERE TRUE",
+			params: map[string]interface{}{"": 1},
+			want:   "WHERE TRUE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, args := ReplaceQueryParams(tt.query, tt.params)
+			if got != tt.want {
+				t.Errorf("ReplaceQueryParams() query = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("ReplaceQueryParams() args = %v, want %v", args, tt.args)
+			}
+		})
+	}
+}
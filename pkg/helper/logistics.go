@@ -0,0 +1,24 @@
+package helper
+
+// WeightUnitKg is how many kilograms one unit of weight is
+var WeightUnitKg = map[string]float64{
+	"g":  0.001,
+	"kg": 1,
+}
+
+// DimensionUnitMeters is how many metres one unit of length is
+var DimensionUnitMeters = map[string]float64{
+	"mm": 0.001,
+	"cm": 0.01,
+	"m":  1,
+}
+
+// VolumeM3 returns the volume of the box in cubic metres, zero when a dimension is missing or the unit is unknown
+func VolumeM3(length, width, height float64, unit string) float64 {
+	meters, ok := DimensionUnitMeters[unit]
+	if !ok {
+		return 0
+	}
+
+	return (length * meters) * (width * meters) * (height * meters)
+}
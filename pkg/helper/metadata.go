@@ -0,0 +1,50 @@
+package helper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	ActorMetadataKey         = "x-actor-id"
+	RequestIdMetadataKey     = "x-request-id"
+	AuthorizationMetadataKey = "authorization"
+)
+
+type claimsContextKey struct{}
+
+// WithClaims returns a context carrying the claims of the verified access token of the call
+func WithClaims(ctx context.Context, claims *TokenClaims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// GetClaims returns the claims of the verified access token of the call, or nil for anonymous calls
+func GetClaims(ctx context.Context) *TokenClaims {
+	claims, _ := ctx.Value(claimsContextKey{}).(*TokenClaims)
+	return claims
+}
+
+// GetMetadataValue returns the first value of the incoming gRPC metadata key or an empty string
+func GetMetadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// GetActor returns the subject of the verified access token of the call, or the actor the caller named without one
+func GetActor(ctx context.Context) string {
+	if claims := GetClaims(ctx); claims != nil && len(claims.Subject) > 0 {
+		return claims.Subject
+	}
+
+	return GetMetadataValue(ctx, ActorMetadataKey)
+}
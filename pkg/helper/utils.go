@@ -1,5 +1,10 @@
 package helper
 
+import (
+	"math"
+	"time"
+)
+
 // IfElse evaluates a condition, if true returns the first parameter otherwise the second
 func IfElse(condition bool, a interface{}, b interface{}) interface{} {
 	if condition {
@@ -7,3 +12,82 @@ func IfElse(condition bool, a interface{}, b interface{}) interface{} {
 	}
 	return b
 }
+
+// MarginPercent returns the share of the selling price that is profit, zero when price or cost is unknown
+func MarginPercent(price, cost float64) float64 {
+	if price <= 0 || cost <= 0 {
+		return 0
+	}
+	return math.Round((price-cost)/price*10000) / 100
+}
+
+// MarkupPercent returns how much the selling price exceeds the cost, zero when the cost is unknown
+func MarkupPercent(price, cost float64) float64 {
+	if cost <= 0 {
+		return 0
+	}
+	return math.Round((price-cost)/cost*10000) / 100
+}
+
+// DailyTimeLayout is the HH:MM format of daily time windows
+const DailyTimeLayout = "15:04"
+
+// InDailyWindow reports whether the clock time of at falls in [from, to), an empty window always matches
+func InDailyWindow(from, to string, at time.Time) bool {
+	if from == "" || to == "" {
+		return true
+	}
+
+	start, err := time.Parse(DailyTimeLayout, from)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(DailyTimeLayout, to)
+	if err != nil {
+		return false
+	}
+
+	minutes := at.Hour()*60 + at.Minute()
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+
+	// windows such as 22:00-02:00 wrap around midnight
+	if startMinutes <= endMinutes {
+		return minutes >= startMinutes && minutes < endMinutes
+	}
+	return minutes >= startMinutes || minutes < endMinutes
+}
+
+// RoundTo rounds the value to the nearest multiple of step
+func RoundTo(value, step float64) float64 {
+	if step <= 0 {
+		return value
+	}
+	return math.Round(math.Round(value/step)*step*100) / 100
+}
+
+// ValidCurrency reports whether the code looks like an ISO 4217 currency code
+func ValidCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// UniqueStrings drops repeated values keeping the first occurrence order
+func UniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,217 @@
+package marking
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	// GroupSeparator is the FNC1 character that terminates variable length elements.
+	GroupSeparator = '\x1d'
+
+	aiGtin       = "01"
+	aiExpiry     = "17"
+	aiBatch      = "10"
+	aiSerial     = "21"
+	aiCryptoKey  = "91"
+	aiCryptoCode = "92"
+	aiVerifyCode = "93"
+
+	gtinLength      = 14
+	maxSerialLength = 20
+)
+
+var (
+	ErrEmptyCode     = errors.New("marking code is empty")
+	ErrMalformedCode = errors.New("marking code is malformed")
+	ErrMissingGtin   = errors.New("marking code has no GTIN")
+	ErrInvalidGtin   = errors.New("marking code GTIN has an invalid check digit")
+	ErrMissingSerial = errors.New("marking code has no serial number")
+)
+
+// fixedLengths holds the data length of the fixed length application identifiers,
+// the other known identifiers run until a group separator or the end of the code
+var fixedLengths = map[string]int{
+	aiGtin:   14,
+	aiExpiry: 6,
+	"11":     6,
+	"3103":   6,
+	"8005":   6,
+}
+
+var variableIdentifiers = []string{aiBatch, aiSerial, aiCryptoKey, aiCryptoCode, aiVerifyCode, "240"}
+
+// Code is a parsed GS1 DataMatrix marking code
+type Code struct {
+	Gtin     string
+	Serial   string
+	Expiry   string
+	Batch    string
+	Elements map[string]string
+}
+
+// Parse reads a GS1 DataMatrix marking code as sent by a scanner, with group separators,
+// or in the human readable form with identifiers in parentheses
+func Parse(raw string) (*Code, error) {
+	raw = strings.TrimSpace(raw)
+	raw = strings.TrimPrefix(raw, "]d2")
+	raw = strings.TrimPrefix(raw, string(GroupSeparator))
+	if len(raw) == 0 {
+		return nil, ErrEmptyCode
+	}
+
+	var (
+		elements map[string]string
+		err      error
+	)
+	if strings.HasPrefix(raw, "(") {
+		elements, err = parseBracketed(raw)
+	} else {
+		elements, err = parseRaw(raw)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	code := &Code{
+		Gtin:     elements[aiGtin],
+		Serial:   elements[aiSerial],
+		Expiry:   elements[aiExpiry],
+		Batch:    elements[aiBatch],
+		Elements: elements,
+	}
+
+	if len(code.Gtin) == 0 {
+		return nil, ErrMissingGtin
+	}
+	if !ValidGtin(code.Gtin) {
+		return nil, ErrInvalidGtin
+	}
+	if len(code.Serial) == 0 || len(code.Serial) > maxSerialLength {
+		return nil, ErrMissingSerial
+	}
+
+	return code, nil
+}
+
+func parseRaw(raw string) (map[string]string, error) {
+	elements := map[string]string{}
+
+	for len(raw) > 0 {
+		if raw[0] == GroupSeparator {
+			raw = raw[1:]
+			continue
+		}
+
+		ai, ok := matchIdentifier(raw)
+		if !ok {
+			return nil, ErrMalformedCode
+		}
+		raw = raw[len(ai):]
+
+		if length, ok := fixedLengths[ai]; ok {
+			if len(raw) < length {
+				return nil, ErrMalformedCode
+			}
+			elements[ai] = raw[:length]
+			raw = raw[length:]
+			continue
+		}
+
+		end := strings.IndexByte(raw, GroupSeparator)
+		if end < 0 {
+			end = len(raw)
+		}
+		elements[ai] = raw[:end]
+		raw = raw[end:]
+	}
+
+	return elements, nil
+}
+
+func parseBracketed(raw string) (map[string]string, error) {
+	elements := map[string]string{}
+
+	for len(raw) > 0 {
+		if raw[0] != '(' {
+			return nil, ErrMalformedCode
+		}
+		closing := strings.IndexByte(raw, ')')
+		if closing < 0 {
+			return nil, ErrMalformedCode
+		}
+		ai := raw[1:closing]
+		raw = raw[closing+1:]
+
+		end := strings.IndexByte(raw, '(')
+		if end < 0 {
+			end = len(raw)
+		}
+		elements[ai] = raw[:end]
+		raw = raw[end:]
+
+		if length, ok := fixedLengths[ai]; ok && len(elements[ai]) != length {
+			return nil, ErrMalformedCode
+		}
+	}
+
+	return elements, nil
+}
+
+func matchIdentifier(raw string) (string, bool) {
+	for ai := range fixedLengths {
+		if strings.HasPrefix(raw, ai) {
+			return ai, true
+		}
+	}
+	for _, ai := range variableIdentifiers {
+		if strings.HasPrefix(raw, ai) {
+			return ai, true
+		}
+	}
+	return "", false
+}
+
+// ValidGtin checks the GS1 check digit of a GTIN-8, 12, 13 or 14
+func ValidGtin(gtin string) bool {
+	switch len(gtin) {
+	case 8, 12, 13, gtinLength:
+	default:
+		return false
+	}
+
+	sum := 0
+	for i := len(gtin) - 2; i >= 0; i-- {
+		d := int(gtin[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		// weights alternate 3, 1, ... starting from the digit next to the check digit
+		if (len(gtin)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	check := int(gtin[len(gtin)-1] - '0')
+	return check == (10-sum%10)%10
+}
+
+// NormalizeGtin zero pads a GTIN-8, 12 or 13 to the 14 digits marking codes carry
+func NormalizeGtin(gtin string) string {
+	if len(gtin) >= gtinLength {
+		return gtin
+	}
+	return strings.Repeat("0", gtinLength-len(gtin)) + gtin
+}
+
+// MatchesGtin reports whether the marking code GTIN is the GTIN of the product
+func MatchesGtin(gtin, productGtin string) bool {
+	return len(productGtin) > 0 && NormalizeGtin(gtin) == NormalizeGtin(productGtin)
+}
+
+// MatchesBarcode reports whether the GTIN encodes the barcode, leading zeros are not significant
+func MatchesBarcode(gtin, barcode string) bool {
+	barcode = strings.TrimLeft(strings.TrimSpace(barcode), "0")
+	return len(barcode) > 0 && strings.TrimLeft(gtin, "0") == barcode
+}
@@ -0,0 +1,178 @@
+package marking
+
+import (
+	"testing"
+)
+
+const gs = string(GroupSeparator)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		want   Code
+		err    error
+		crypto string
+	}{
+		{
+			name:   "group separators",
+			raw:    "0104600439931256" + "21JgXJ5.T" + gs + "91EE06" + gs + "92YWCXbmK6SN8vvwoxZFk7WAY8WoJNMGGr6Cgtiuja04c=",
+			want:   Code{Gtin: "04600439931256", Serial: "JgXJ5.T"},
+			crypto: "YWCXbmK6SN8vvwoxZFk7WAY8WoJNMGGr6Cgtiuja04c=",
+		},
+		{
+			name: "scanner symbology prefix and leading FNC1",
+			raw:  "]d2" + gs + "0104600439931256" + "21SERIAL" + gs + "93dGVz",
+			want: Code{Gtin: "04600439931256", Serial: "SERIAL"},
+		},
+		{
+			name: "serial at the end without separator",
+			raw:  "010460043993125621ABC123",
+			want: Code{Gtin: "04600439931256", Serial: "ABC123"},
+		},
+		{
+			name: "expiry and batch",
+			raw:  "0104600439931256" + "17261231" + "10LOT7" + gs + "21XYZ",
+			want: Code{Gtin: "04600439931256", Serial: "XYZ", Expiry: "261231", Batch: "LOT7"},
+		},
+		{
+			name:   "bracketed",
+			raw:    "(01)04600439931256(21)JgXJ5.T(91)EE06(92)abc=",
+			want:   Code{Gtin: "04600439931256", Serial: "JgXJ5.T"},
+			crypto: "abc=",
+		},
+		{
+			name: "bad check digit",
+			raw:  "0104600439931257" + "21JgXJ5.T",
+			err:  ErrInvalidGtin,
+		},
+		{
+			name: "bad check digit bracketed",
+			raw:  "(01)04600439931250(21)JgXJ5.T",
+			err:  ErrInvalidGtin,
+		},
+		{
+			name: "empty",
+			raw:  "  ",
+			err:  ErrEmptyCode,
+		},
+		{
+			name: "no gtin",
+			raw:  "21JgXJ5.T" + gs + "91EE06",
+			err:  ErrMissingGtin,
+		},
+		{
+			name: "no serial",
+			raw:  "0104600439931256" + "91EE06",
+			err:  ErrMissingSerial,
+		},
+		{
+			name: "serial too long",
+			raw:  "0104600439931256" + "21ABCDEFGHIJKLMNOPQRSTU",
+			err:  ErrMissingSerial,
+		},
+		{
+			name: "truncated gtin",
+			raw:  "01046004399",
+			err:  ErrMalformedCode,
+		},
+		{
+			name: "unknown identifier",
+			raw:  "0104600439931256" + "21ABC" + gs + "99XYZ",
+			err:  ErrMalformedCode,
+		},
+		{
+			name: "bracketed gtin of wrong length",
+			raw:  "(01)4600439931256(21)ABC",
+			err:  ErrMalformedCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Parse(tt.raw)
+			if err != tt.err {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			if code.Gtin != tt.want.Gtin || code.Serial != tt.want.Serial ||
+				code.Expiry != tt.want.Expiry || code.Batch != tt.want.Batch {
+				t.Errorf("Parse() = %+v, want %+v", code, tt.want)
+			}
+			if len(tt.crypto) > 0 && code.Elements[aiCryptoCode] != tt.crypto {
+				t.Errorf("Parse() crypto code = %q, want %q", code.Elements[aiCryptoCode], tt.crypto)
+			}
+		})
+	}
+}
+
+func TestValidGtin(t *testing.T) {
+	tests := []struct {
+		gtin string
+		want bool
+	}{
+		{"04600439931256", true},
+		{"4600439931256", true},
+		{"12345678905", false},
+		{"123456789050", true},
+		{"40063812", true},
+		{"40063813", false},
+		{"04600439931257", false},
+		{"0460043993125A", false},
+		{"", false},
+		{"ABC123XYZ", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidGtin(tt.gtin); got != tt.want {
+			t.Errorf("ValidGtin(%q) = %v, want %v", tt.gtin, got, tt.want)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name         string
+		gtin         string
+		productGtin  string
+		barcode      string
+		gtinMatch    bool
+		barcodeMatch bool
+	}{
+		{"same gtin", "04600439931256", "04600439931256", "", true, false},
+		{"ean13 product gtin", "04600439931256", "4600439931256", "", true, false},
+		{"other gtin", "04600439931256", "00000040063812", "", false, false},
+		{"ean13 barcode", "04600439931256", "", "4600439931256", false, true},
+		{"generated barcode", "04600439931256", "", "A1B2C3D4E", false, false},
+		{"zero barcode", "00000000000000", "", "000", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchesGtin(tt.gtin, tt.productGtin); got != tt.gtinMatch {
+				t.Errorf("MatchesGtin() = %v, want %v", got, tt.gtinMatch)
+			}
+			if got := MatchesBarcode(tt.gtin, tt.barcode); got != tt.barcodeMatch {
+				t.Errorf("MatchesBarcode() = %v, want %v", got, tt.barcodeMatch)
+			}
+		})
+	}
+}
+
+func TestNormalizeGtin(t *testing.T) {
+	tests := map[string]string{
+		"40063812":       "00000040063812",
+		"123456789050":   "00123456789050",
+		"4600439931256":  "04600439931256",
+		"04600439931256": "04600439931256",
+	}
+
+	for gtin, want := range tests {
+		if got := NormalizeGtin(gtin); got != want {
+			t.Errorf("NormalizeGtin(%q) = %q, want %q", gtin, got, want)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package promotion
+
+import (
+	"errors"
+	"math"
+	"product_service/pkg/helper"
+	"sort"
+	"time"
+)
+
+const (
+	// TypePercentOff takes a percentage off every matching line.
+	TypePercentOff = "percent_off"
+	// TypeFixedOff takes a fixed amount off every matching unit.
+	TypeFixedOff = "fixed_off"
+	// TypeBuyXGetY makes the cheapest Get units free for every Buy+Get matching units.
+	TypeBuyXGetY = "buy_x_get_y"
+	// TypeFixedPriceBundle sells one unit of each listed product for BundlePrice.
+	TypeFixedPriceBundle = "fixed_price_bundle"
+)
+
+// Rule is a promotion definition: conditions select the lines, the action decides the discount
+type Rule struct {
+	Id       string
+	Name     string
+	Priority int32
+
+	ProductIds  []string
+	CategoryIds []string
+	FilialIds   []string
+	MinQuantity float64
+	DailyFrom   string
+	DailyTo     string
+
+	Type        string
+	Percent     float64
+	Amount      float64
+	BuyQuantity float64
+	GetQuantity float64
+	BundlePrice float64
+}
+
+// Line is a basket line priced from the catalog
+type Line struct {
+	ProductId  string
+	CategoryId string
+	UnitPrice  float64
+	Quantity   float64
+}
+
+type LineResult struct {
+	Line
+	BaseTotal    float64
+	Discount     float64
+	FinalTotal   float64
+	PromotionIds []string
+}
+
+type Applied struct {
+	PromotionId string
+	Name        string
+	Discount    float64
+}
+
+type Result struct {
+	Lines         []*LineResult
+	Applied       []Applied
+	BaseTotal     float64
+	DiscountTotal float64
+	Total         float64
+}
+
+// Validate checks that the rule has everything its action type needs
+func Validate(rule Rule) error {
+	if rule.Name == "" {
+		return errors.New("promotion name is required")
+	}
+
+	switch rule.Type {
+	case TypePercentOff:
+		if rule.Percent <= 0 || rule.Percent > 100 {
+			return errors.New("percent must be in (0, 100]")
+		}
+	case TypeFixedOff:
+		if rule.Amount <= 0 {
+			return errors.New("amount must be greater than zero")
+		}
+	case TypeBuyXGetY:
+		if rule.BuyQuantity < 1 || rule.GetQuantity < 1 {
+			return errors.New("buy_quantity and get_quantity must be at least 1")
+		}
+	case TypeFixedPriceBundle:
+		if len(rule.ProductIds) < 2 {
+			return errors.New("bundle needs at least two products")
+		}
+		if rule.BundlePrice <= 0 {
+			return errors.New("bundle_price must be greater than zero")
+		}
+	default:
+		return errors.New("unknown promotion type: " + rule.Type)
+	}
+
+	if (rule.DailyFrom == "") != (rule.DailyTo == "") {
+		return errors.New("daily_from and daily_to must be set together")
+	}
+	if rule.DailyFrom != "" {
+		if _, err := time.Parse(helper.DailyTimeLayout, rule.DailyFrom); err != nil {
+			return errors.New("daily_from must be in HH:MM format")
+		}
+		if _, err := time.Parse(helper.DailyTimeLayout, rule.DailyTo); err != nil {
+			return errors.New("daily_to must be in HH:MM format")
+		}
+	}
+
+	return nil
+}
+
+// Evaluate applies the rules to the basket in priority order, every line takes part in at most one promotion
+func Evaluate(lines []Line, rules []Rule, filialId string, at time.Time) *Result {
+	result := &Result{}
+
+	for _, line := range lines {
+		base := round(line.UnitPrice * line.Quantity)
+		result.Lines = append(result.Lines, &LineResult{
+			Line:       line,
+			BaseTotal:  base,
+			FinalTotal: base,
+		})
+		result.BaseTotal += base
+	}
+
+	sorted := make([]Rule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority > sorted[j].Priority
+	})
+
+	for _, rule := range sorted {
+		if !inFilial(rule, filialId) || !inDailyWindow(rule, at) {
+			continue
+		}
+
+		var matched []*LineResult
+		var quantity float64
+		for _, line := range result.Lines {
+			if len(line.PromotionIds) == 0 && matches(rule, line.Line) {
+				matched = append(matched, line)
+				quantity += line.Quantity
+			}
+		}
+		if len(matched) == 0 || quantity < rule.MinQuantity {
+			continue
+		}
+
+		discounts := discountsFor(rule, matched)
+
+		var total float64
+		for i, line := range matched {
+			discounts[i] = round(math.Min(discounts[i], line.FinalTotal))
+			total += discounts[i]
+		}
+		if total <= 0 {
+			continue
+		}
+
+		// lines that qualified for the promotion are consumed by it even when their own discount is zero,
+		// e.g. the paid units of "buy 2 get 1"
+		for i, line := range matched {
+			line.Discount = round(line.Discount + discounts[i])
+			line.FinalTotal = round(line.FinalTotal - discounts[i])
+			line.PromotionIds = append(line.PromotionIds, rule.Id)
+		}
+
+		result.Applied = append(result.Applied, Applied{
+			PromotionId: rule.Id,
+			Name:        rule.Name,
+			Discount:    round(total),
+		})
+		result.DiscountTotal += total
+	}
+
+	result.BaseTotal = round(result.BaseTotal)
+	result.DiscountTotal = round(result.DiscountTotal)
+	result.Total = round(result.BaseTotal - result.DiscountTotal)
+
+	return result
+}
+
+func discountsFor(rule Rule, lines []*LineResult) []float64 {
+	discounts := make([]float64, len(lines))
+
+	switch rule.Type {
+	case TypePercentOff:
+		for i, line := range lines {
+			discounts[i] = line.BaseTotal * rule.Percent / 100
+		}
+	case TypeFixedOff:
+		for i, line := range lines {
+			discounts[i] = math.Min(rule.Amount, line.UnitPrice) * line.Quantity
+		}
+	case TypeBuyXGetY:
+		// units are ranked from the most to the least expensive, the last Get of every Buy+Get group are free
+		type unit struct {
+			line  int
+			price float64
+		}
+		var units []unit
+		for i, line := range lines {
+			for n := 0; n < int(math.Floor(line.Quantity)); n++ {
+				units = append(units, unit{line: i, price: line.UnitPrice})
+			}
+		}
+		sort.SliceStable(units, func(i, j int) bool {
+			return units[i].price > units[j].price
+		})
+
+		group := int(rule.BuyQuantity + rule.GetQuantity)
+		for n := range units {
+			if n%group >= int(rule.BuyQuantity) && len(units)-n+n%group >= group {
+				discounts[units[n].line] += units[n].price
+			}
+		}
+	case TypeFixedPriceBundle:
+		// a product may be on several lines, its quantity is their sum
+		quantities := make(map[string]float64)
+		prices := make(map[string]float64)
+		for _, line := range lines {
+			quantities[line.ProductId] += line.Quantity
+			if _, ok := prices[line.ProductId]; !ok {
+				prices[line.ProductId] = line.UnitPrice
+			}
+		}
+
+		bundles := math.Inf(1)
+		var fullPrice float64
+		for _, productId := range rule.ProductIds {
+			quantity, ok := quantities[productId]
+			if !ok {
+				return discounts
+			}
+			bundles = math.Min(bundles, math.Floor(quantity))
+			fullPrice += prices[productId]
+		}
+		if bundles < 1 || fullPrice <= rule.BundlePrice {
+			return discounts
+		}
+
+		// the bundle discount is spread over its components in proportion to their prices,
+		// and over the lines of a component in proportion to their quantities
+		saving := fullPrice - rule.BundlePrice
+		for i, line := range lines {
+			if contains(rule.ProductIds, line.ProductId) {
+				share := line.Quantity / quantities[line.ProductId]
+				discounts[i] = bundles * saving * prices[line.ProductId] / fullPrice * share
+			}
+		}
+	}
+
+	return discounts
+}
+
+func matches(rule Rule, line Line) bool {
+	if len(rule.ProductIds) == 0 && len(rule.CategoryIds) == 0 {
+		return true
+	}
+
+	return contains(rule.ProductIds, line.ProductId) || contains(rule.CategoryIds, line.CategoryId)
+}
+
+func inFilial(rule Rule, filialId string) bool {
+	return len(rule.FilialIds) == 0 || contains(rule.FilialIds, filialId)
+}
+
+func inDailyWindow(rule Rule, at time.Time) bool {
+	return helper.InDailyWindow(rule.DailyFrom, rule.DailyTo, at)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
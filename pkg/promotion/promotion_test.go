@@ -0,0 +1,230 @@
+package promotion
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEvaluate(t *testing.T) {
+	noon := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	lateEvening := time.Date(2024, 5, 1, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		lines     []Line
+		rules     []Rule
+		filialId  string
+		at        time.Time
+		discounts []float64
+		applied   []string
+		total     float64
+	}{
+		{
+			name:      "percent off the matching product",
+			lines:     []Line{{ProductId: "a", UnitPrice: 100, Quantity: 2}, {ProductId: "b", UnitPrice: 50, Quantity: 1}},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypePercentOff, Percent: 10, ProductIds: []string{"a"}}},
+			at:        noon,
+			discounts: []float64{20, 0},
+			applied:   []string{"p1"},
+			total:     230,
+		},
+		{
+			name:      "fixed off never exceeds the unit price",
+			lines:     []Line{{ProductId: "a", CategoryId: "c", UnitPrice: 20, Quantity: 3}, {ProductId: "b", CategoryId: "c", UnitPrice: 3, Quantity: 2}},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypeFixedOff, Amount: 5, CategoryIds: []string{"c"}}},
+			at:        noon,
+			discounts: []float64{15, 6},
+			applied:   []string{"p1"},
+			total:     45,
+		},
+		{
+			name: "buy two get one makes the cheapest unit of the group free",
+			lines: []Line{
+				{ProductId: "a", UnitPrice: 100, Quantity: 2},
+				{ProductId: "b", UnitPrice: 50, Quantity: 1},
+				{ProductId: "c", UnitPrice: 10, Quantity: 1},
+			},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1}},
+			at:        noon,
+			discounts: []float64{0, 50, 0},
+			applied:   []string{"p1"},
+			total:     210,
+		},
+		{
+			name:      "buy two get one without a full group",
+			lines:     []Line{{ProductId: "a", UnitPrice: 100, Quantity: 2}},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1}},
+			at:        noon,
+			discounts: []float64{0},
+			total:     200,
+		},
+		{
+			name:      "bundle saving is spread by price",
+			lines:     []Line{{ProductId: "a", UnitPrice: 60, Quantity: 1}, {ProductId: "b", UnitPrice: 40, Quantity: 1}},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypeFixedPriceBundle, ProductIds: []string{"a", "b"}, BundlePrice: 80}},
+			at:        noon,
+			discounts: []float64{12, 8},
+			applied:   []string{"p1"},
+			total:     80,
+		},
+		{
+			name: "bundle product on two lines is discounted once",
+			lines: []Line{
+				{ProductId: "a", UnitPrice: 60, Quantity: 1},
+				{ProductId: "a", UnitPrice: 60, Quantity: 1},
+				{ProductId: "b", UnitPrice: 40, Quantity: 1},
+			},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypeFixedPriceBundle, ProductIds: []string{"a", "b"}, BundlePrice: 80}},
+			at:        noon,
+			discounts: []float64{6, 6, 8},
+			applied:   []string{"p1"},
+			total:     140,
+		},
+		{
+			name: "bundle counted over split lines",
+			lines: []Line{
+				{ProductId: "a", UnitPrice: 60, Quantity: 1},
+				{ProductId: "b", UnitPrice: 40, Quantity: 1},
+				{ProductId: "a", UnitPrice: 60, Quantity: 1},
+				{ProductId: "b", UnitPrice: 40, Quantity: 1},
+			},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypeFixedPriceBundle, ProductIds: []string{"a", "b"}, BundlePrice: 80}},
+			at:        noon,
+			discounts: []float64{12, 8, 12, 8},
+			applied:   []string{"p1"},
+			total:     160,
+		},
+		{
+			name:      "bundle with a missing component",
+			lines:     []Line{{ProductId: "a", UnitPrice: 60, Quantity: 1}},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypeFixedPriceBundle, ProductIds: []string{"a", "b"}, BundlePrice: 80}},
+			at:        noon,
+			discounts: []float64{0},
+			total:     60,
+		},
+		{
+			name:  "higher priority takes the line first and promotions do not stack",
+			lines: []Line{{ProductId: "a", UnitPrice: 100, Quantity: 1}, {ProductId: "b", UnitPrice: 100, Quantity: 1}},
+			rules: []Rule{
+				{Id: "p1", Name: "p1", Priority: 1, Type: TypePercentOff, Percent: 50},
+				{Id: "p2", Name: "p2", Priority: 2, Type: TypeFixedOff, Amount: 10, ProductIds: []string{"a"}},
+			},
+			at:        noon,
+			discounts: []float64{10, 50},
+			applied:   []string{"p2", "p1"},
+			total:     140,
+		},
+		{
+			name:      "minimum quantity not reached",
+			lines:     []Line{{ProductId: "a", UnitPrice: 100, Quantity: 2}},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypePercentOff, Percent: 10, MinQuantity: 3}},
+			at:        noon,
+			discounts: []float64{0},
+			total:     200,
+		},
+		{
+			name:      "other filial",
+			lines:     []Line{{ProductId: "a", UnitPrice: 100, Quantity: 1}},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypePercentOff, Percent: 10, FilialIds: []string{"f1"}}},
+			filialId:  "f2",
+			at:        noon,
+			discounts: []float64{0},
+			total:     100,
+		},
+		{
+			name:      "daily window wrapping midnight",
+			lines:     []Line{{ProductId: "a", UnitPrice: 100, Quantity: 1}},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypePercentOff, Percent: 10, DailyFrom: "22:00", DailyTo: "02:00"}},
+			at:        lateEvening,
+			discounts: []float64{10},
+			applied:   []string{"p1"},
+			total:     90,
+		},
+		{
+			name:      "outside the daily window",
+			lines:     []Line{{ProductId: "a", UnitPrice: 100, Quantity: 1}},
+			rules:     []Rule{{Id: "p1", Name: "p1", Type: TypePercentOff, Percent: 10, DailyFrom: "22:00", DailyTo: "02:00"}},
+			at:        noon,
+			discounts: []float64{0},
+			total:     100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Evaluate(tt.lines, tt.rules, tt.filialId, tt.at)
+
+			var discounts []float64
+			for _, line := range result.Lines {
+				discounts = append(discounts, line.Discount)
+				if line.FinalTotal != round(line.BaseTotal-line.Discount) {
+					t.Errorf("line %s final total = %v, want %v", line.ProductId, line.FinalTotal, line.BaseTotal-line.Discount)
+				}
+			}
+			if !reflect.DeepEqual(discounts, tt.discounts) {
+				t.Errorf("Evaluate() line discounts = %v, want %v", discounts, tt.discounts)
+			}
+
+			var applied []string
+			for _, a := range result.Applied {
+				applied = append(applied, a.PromotionId)
+			}
+			if !reflect.DeepEqual(applied, tt.applied) {
+				t.Errorf("Evaluate() applied = %v, want %v", applied, tt.applied)
+			}
+
+			if result.Total != tt.total {
+				t.Errorf("Evaluate() total = %v, want %v", result.Total, tt.total)
+			}
+		})
+	}
+}
+
+func TestEvaluateConsumesQualifyingLines(t *testing.T) {
+	lines := []Line{
+		{ProductId: "a", UnitPrice: 100, Quantity: 2},
+		{ProductId: "b", UnitPrice: 50, Quantity: 1},
+	}
+	rules := []Rule{
+		{Id: "p1", Name: "p1", Priority: 2, Type: TypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1},
+		{Id: "p2", Name: "p2", Priority: 1, Type: TypePercentOff, Percent: 50},
+	}
+
+	result := Evaluate(lines, rules, "", time.Now())
+
+	// the paid units of "buy 2 get 1" belong to it and take no other promotion
+	if result.Lines[0].Discount != 0 || !reflect.DeepEqual(result.Lines[0].PromotionIds, []string{"p1"}) {
+		t.Errorf("paid line = %+v, want no discount and promotion p1", result.Lines[0])
+	}
+	if result.Total != 200 {
+		t.Errorf("Evaluate() total = %v, want 200", result.Total)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  Rule
+		valid bool
+	}{
+		{name: "percent off", rule: Rule{Name: "p", Type: TypePercentOff, Percent: 10}, valid: true},
+		{name: "percent over 100", rule: Rule{Name: "p", Type: TypePercentOff, Percent: 120}},
+		{name: "fixed off without amount", rule: Rule{Name: "p", Type: TypeFixedOff}},
+		{name: "buy x get y without get", rule: Rule{Name: "p", Type: TypeBuyXGetY, BuyQuantity: 2}},
+		{name: "bundle of one product", rule: Rule{Name: "p", Type: TypeFixedPriceBundle, ProductIds: []string{"a"}, BundlePrice: 1}},
+		{name: "unknown type", rule: Rule{Name: "p", Type: "gift"}},
+		{name: "no name", rule: Rule{Type: TypePercentOff, Percent: 10}},
+		{name: "half daily window", rule: Rule{Name: "p", Type: TypePercentOff, Percent: 10, DailyFrom: "10:00"}},
+		{name: "bad daily time", rule: Rule{Name: "p", Type: TypePercentOff, Percent: 10, DailyFrom: "10", DailyTo: "12:00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.rule)
+			if (err == nil) != tt.valid {
+				t.Errorf("Validate() error = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type auditRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewAuditRepo(db *pgxpool.Pool) *auditRepo {
+	return &auditRepo{
+		db: db,
+	}
+}
+
+func (c *auditRepo) GetList(ctx context.Context, entityType string, req *product_service.ListHistoryRequest) (resp *product_service.ListHistoryResponse, err error) {
+	resp = &product_service.ListHistoryResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE entity_type = :entity_type "
+		sort   = " ORDER BY created_at DESC "
+	)
+
+	params["entity_type"] = entityType
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   entity_type,
+			   entity_id,
+			   action,
+			   actor,
+			   request_id,
+			   before,
+			   after,
+			   diff,
+			   created_at
+		FROM "audit_log"
+	`
+	if len(req.GetEntityId()) > 0 {
+		filter += " AND entity_id = :entity_id "
+		params["entity_id"] = req.EntityId
+	}
+	if len(req.GetActor()) > 0 {
+		filter += " AND actor = :actor "
+		params["actor"] = req.Actor
+	}
+	if len(req.GetFrom()) > 0 {
+		filter += " AND created_at >= :from "
+		params["from"] = req.From
+	}
+	if len(req.GetTo()) > 0 {
+		filter += " AND created_at <= :to "
+		params["to"] = req.To
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id          sql.NullString
+			entity_type sql.NullString
+			entity_id   sql.NullString
+			action      sql.NullString
+			actor       sql.NullString
+			request_id  sql.NullString
+			before      []byte
+			after       []byte
+			diff        []byte
+			created_at  sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&entity_type,
+			&entity_id,
+			&action,
+			&actor,
+			&request_id,
+			&before,
+			&after,
+			&diff,
+			&created_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		record := &product_service.AuditRecord{
+			Id:         id.String,
+			EntityType: entity_type.String,
+			EntityId:   entity_id.String,
+			Action:     action.String,
+			Actor:      actor.String,
+			RequestId:  request_id.String,
+			CreatedAt:  created_at.String,
+		}
+
+		if record.Before, err = unmarshalJSONB(before); err != nil {
+			return resp, err
+		}
+		if record.After, err = unmarshalJSONB(after); err != nil {
+			return resp, err
+		}
+		if record.Diff, err = unmarshalJSONB(diff); err != nil {
+			return resp, err
+		}
+
+		resp.Records = append(resp.Records, record)
+	}
+
+	return
+}
+
+func unmarshalJSONB(body []byte) (*structpb.Struct, error) {
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	result := &structpb.Struct{}
+	if err := protojson.Unmarshal(body, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// setAuditContext names the actor, the request and the action the audit log records the product and category changes
+// of the transaction under, an empty action lets the audit trigger name it after the operation
+func setAuditContext(ctx context.Context, tx pgx.Tx, action string) error {
+	_, err := tx.Exec(ctx, `
+		SELECT
+			set_config('audit.actor', $1, true),
+			set_config('audit.request_id', $2, true),
+			set_config('audit.action', $3, true)
+	`, helper.GetActor(ctx), helper.GetMetadataValue(ctx, helper.RequestIdMetadataKey), action)
+
+	return err
+}
+
+// execAudited runs one statement changing products or categories in a transaction of its own,
+// so that the change and its audit record are committed together
+func execAudited(ctx context.Context, db *pgxpool.Pool, action, query string, args ...interface{}) (rowsAffected int64, err error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = setAuditContext(ctx, tx, action)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), tx.Commit(ctx)
+}
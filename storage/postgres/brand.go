@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type brandRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewBrandRepo(db *pgxpool.Pool) *brandRepo {
+	return &brandRepo{
+		db: db,
+	}
+}
+
+func (c *brandRepo) Create(ctx context.Context, req *product_service.CreateBrand) (resp *product_service.BrandPK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "brand" (
+			id,
+			name,
+			manufacturer_id,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, NOW(), NOW())
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.Name,
+		helper.NewNullString(req.ManufacturerId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.BrandPK{Id: id}, nil
+}
+
+func (c *brandRepo) GetByID(ctx context.Context, req *product_service.BrandPK) (resp *product_service.Brand, err error) {
+	query := `
+		SELECT
+			id,
+			name,
+			manufacturer_id,
+			created_at,
+			updated_at
+		FROM "brand"
+		WHERE id = $1;
+	`
+	var (
+		id              sql.NullString
+		name            sql.NullString
+		manufacturer_id sql.NullString
+		created_at      sql.NullString
+		updated_at      sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&name,
+		&manufacturer_id,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return resp, err
+	}
+
+	resp = &product_service.Brand{
+		Id:             id.String,
+		Name:           name.String,
+		ManufacturerId: manufacturer_id.String,
+		CreatedAt:      created_at.String,
+		UpdatedAt:      updated_at.String,
+	}
+
+	return
+}
+
+func (c *brandRepo) GetList(ctx context.Context, req *product_service.GetListBrandRequest) (resp *product_service.GetListBrandResponse, err error) {
+	resp = &product_service.GetListBrandResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY name "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   name,
+			   manufacturer_id,
+			   created_at,
+			   updated_at
+		FROM "brand"
+	`
+	if len(req.GetSearch()) > 0 {
+		filter += " AND name ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id              sql.NullString
+			name            sql.NullString
+			manufacturer_id sql.NullString
+			created_at      sql.NullString
+			updated_at      sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&name,
+			&manufacturer_id,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Brands = append(resp.Brands, &product_service.Brand{
+			Id:             id.String,
+			Name:           name.String,
+			ManufacturerId: manufacturer_id.String,
+			CreatedAt:      created_at.String,
+			UpdatedAt:      updated_at.String,
+		})
+	}
+
+	return
+}
+
+func (c *brandRepo) Update(ctx context.Context, req *product_service.UpdateBrand) (resp int64, err error) {
+	query := `
+		UPDATE
+			"brand"
+		SET
+			name = $2,
+			manufacturer_id = $3,
+			updated_at = now()
+		WHERE id = $1
+	`
+
+	result, err := c.db.Exec(ctx, query, req.GetId(), req.GetName(), helper.NewNullString(req.GetManufacturerId()))
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *brandRepo) Delete(ctx context.Context, req *product_service.BrandPK) error {
+	query := `DELETE FROM "brand" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
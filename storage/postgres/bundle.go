@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/models"
+	"product_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// bundleComponentPrice is the price of component p in the currency of bundle b, NULL when there is no exchange rate
+const bundleComponentPrice = `p.price * CASE WHEN p.currency = b.currency THEN 1
+					ELSE currency_rate(p.currency, NOW()::timestamp) / currency_rate(b.currency, NOW()::timestamp)
+				END`
+
+type bundleRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewBundleRepo(db *pgxpool.Pool) *bundleRepo {
+	return &bundleRepo{
+		db: db,
+	}
+}
+
+// Set replaces the pricing and the components of the bundle, a bundle without components becomes a plain product again
+func (c *bundleRepo) Set(ctx context.Context, req *product_service.SetBundleRequest) (rowsAffected int64, err error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = setAuditContext(ctx, tx, models.AuditActionBundle)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(ctx, `
+		UPDATE "product"
+		SET
+			is_bundle = $2,
+			bundle_pricing = $3,
+			bundle_discount = $4,
+			version = version + 1,
+			updated_at = now()
+		WHERE id = $1
+	`, req.GetProductId(), len(req.GetComponents()) > 0, helper.NewNullString(req.GetPricing()), req.GetDiscount())
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected = result.RowsAffected()
+	if rowsAffected == 0 {
+		return 0, nil
+	}
+
+	// bundles do not nest: the bundle must not be a component and its components must not be bundles,
+	// the locks make a concurrent SetBundle of a component wait for this one and see its result
+	if len(req.GetComponents()) > 0 {
+		var isComponent bool
+		err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM "bundle_component" WHERE component_id = $1)`, req.GetProductId()).Scan(&isComponent)
+		if err != nil {
+			return 0, err
+		}
+		if isComponent {
+			return 0, errors.New(config.ErrBundleIsComponent)
+		}
+
+		componentIds := make([]string, 0, len(req.GetComponents()))
+		for _, component := range req.GetComponents() {
+			componentIds = append(componentIds, component.GetProductId())
+		}
+
+		var name sql.NullString
+		err = tx.QueryRow(ctx, `
+			SELECT name FROM (
+				SELECT name, is_bundle FROM "product" WHERE id = ANY($1) ORDER BY id FOR SHARE
+			) components
+			WHERE is_bundle
+			LIMIT 1
+		`, componentIds).Scan(&name)
+		if err == nil {
+			return 0, errors.New("component " + name.String + " is a bundle itself")
+		}
+		if err != pgx.ErrNoRows {
+			return 0, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM "bundle_component" WHERE bundle_id = $1`, req.GetProductId())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, component := range req.GetComponents() {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "bundle_component" (
+				bundle_id,
+				component_id,
+				quantity,
+				created_at
+			) VALUES ($1, $2, $3, NOW())
+		`, req.GetProductId(), component.GetProductId(), component.GetQuantity())
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return rowsAffected, tx.Commit(ctx)
+}
+
+// GetComponents returns the components of the bundle with their current names and prices in the currency of the bundle,
+// the price of a component without an exchange rate is zero
+func (c *bundleRepo) GetComponents(ctx context.Context, bundleId string) (resp []*product_service.BundleComponent, err error) {
+	query := `
+		SELECT
+			bc.component_id,
+			p.name,
+			bc.quantity,
+			` + bundleComponentPrice + `
+		FROM "bundle_component" bc
+		JOIN "product" b ON b.id = bc.bundle_id
+		JOIN "product" p ON p.id = bc.component_id
+		WHERE bc.bundle_id = $1
+		ORDER BY bc.created_at, p.name
+	`
+
+	rows, err := c.db.Query(ctx, query, bundleId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			component_id sql.NullString
+			name         sql.NullString
+			quantity     sql.NullFloat64
+			price        sql.NullFloat64
+		)
+
+		err := rows.Scan(&component_id, &name, &quantity, &price)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, &product_service.BundleComponent{
+			ProductId: component_id.String,
+			Name:      name.String,
+			Quantity:  quantity.Float64,
+			Price:     float32(price.Float64),
+		})
+	}
+
+	return resp, rows.Err()
+}
+
+// GetComponentTotals returns the sum of component prices times quantities keyed by bundle id, in the currency of the bundle.
+// Bundles with a component that has no exchange rate to the bundle currency are left out
+func (c *bundleRepo) GetComponentTotals(ctx context.Context, bundleIds []string) (resp map[string]float64, err error) {
+	resp = make(map[string]float64)
+
+	query := `
+		SELECT bundle_id, SUM(total) FROM (
+			SELECT
+				bc.bundle_id,
+				` + bundleComponentPrice + ` * bc.quantity AS total
+			FROM "bundle_component" bc
+			JOIN "product" b ON b.id = bc.bundle_id
+			JOIN "product" p ON p.id = bc.component_id
+			WHERE bc.bundle_id = ANY($1)
+		) components
+		GROUP BY bundle_id
+		HAVING COUNT(*) = COUNT(total)
+	`
+
+	rows, err := c.db.Query(ctx, query, bundleIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			bundle_id sql.NullString
+			total     sql.NullFloat64
+		)
+
+		err := rows.Scan(&bundle_id, &total)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[bundle_id.String] = total.Float64
+	}
+
+	return resp, rows.Err()
+}
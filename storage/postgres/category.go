@@ -31,17 +31,23 @@ func (c *categoryRepo) Create(ctx context.Context, req *product_service.CreateCa
 			id,
 			name,
 			parent,
+			tax_rate_id,
+			requires_marking,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, NOW(), NOW())
+		) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
 	`
 
-	_, err = c.db.Exec(
+	_, err = execAudited(
 		ctx,
+		c.db,
+		models.AuditActionCreate,
 		query,
 		id,
 		req.Name,
 		req.Parent,
+		helper.NewNullString(req.TaxRateId),
+		req.RequiresMarking,
 	)
 	if err != nil {
 		fmt.Println(err)
@@ -52,41 +58,63 @@ func (c *categoryRepo) Create(ctx context.Context, req *product_service.CreateCa
 }
 
 func (c *categoryRepo) GetByID(ctx context.Context, req *product_service.CategoryPK) (order *product_service.Category, err error) {
+	var (
+		from = `"category"`
+		args = []interface{}{req.Id}
+	)
+
+	if len(req.GetAsOf()) > 0 {
+		from = categoryAsOf("$2")
+		args = append(args, req.AsOf)
+	}
+
 	query := `
 		SELECT 
 		    id,
 			name,
 			parent,
 			created_at,
-			updated_at
-		FROM "category"
+			updated_at,
+			version,
+			tax_rate_id,
+			requires_marking
+		FROM ` + from + `
 		WHERE id = $1;
 	`
 	var (
-		id         sql.NullString
-		name       sql.NullString
-		parent     sql.NullString
-		created_at sql.NullString
-		updated_at sql.NullString
+		id               sql.NullString
+		name             sql.NullString
+		parent           sql.NullString
+		created_at       sql.NullString
+		updated_at       sql.NullString
+		version          sql.NullInt64
+		tax_rate_id      sql.NullString
+		requires_marking sql.NullBool
 	)
 
-	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+	err = c.db.QueryRow(ctx, query, args...).Scan(
 		&id,
 		&name,
 		&parent,
 		&created_at,
 		&updated_at,
+		&version,
+		&tax_rate_id,
+		&requires_marking,
 	)
 	if err != nil {
 		return order, err
 	}
 
 	order = &product_service.Category{
-		Id:        id.String,
-		Name:      name.String,
-		Parent:    parent.String,
-		CreatedAt: created_at.String,
-		UpdatedAt: updated_at.String,
+		Id:              id.String,
+		Name:            name.String,
+		Parent:          parent.String,
+		CreatedAt:       created_at.String,
+		UpdatedAt:       updated_at.String,
+		Version:         version.Int64,
+		TaxRateId:       tax_rate_id.String,
+		RequiresMarking: requires_marking.Bool,
 	}
 
 	return
@@ -101,6 +129,7 @@ func (c *categoryRepo) GetList(ctx context.Context, req *product_service.GetList
 		offset = " OFFSET 0 "
 		params = make(map[string]interface{})
 		filter = " WHERE TRUE "
+		from   = `"category"`
 		sort   = " ORDER BY created_at DESC "
 	)
 
@@ -111,9 +140,15 @@ func (c *categoryRepo) GetList(ctx context.Context, req *product_service.GetList
 			   name,
 			   parent,
 			   created_at,
-			   updated_at
-		FROM "category"
-	`
+			   updated_at,
+			   version,
+			   tax_rate_id,
+			   requires_marking
+		FROM `
+	if len(req.GetAsOf()) > 0 {
+		from = categoryAsOf(":as_of")
+		params["as_of"] = req.AsOf
+	}
 	if len(req.GetSearch()) > 0 {
 		filter += " AND name ILIKE '%' || '" + req.Search + "' || '%' "
 	}
@@ -125,7 +160,7 @@ func (c *categoryRepo) GetList(ctx context.Context, req *product_service.GetList
 		offset = " OFFSET :offset"
 		params["offset"] = req.Offset
 	}
-	query += filter + sort + offset + limit
+	query += from + filter + sort + offset + limit
 
 	query, args := helper.ReplaceQueryParams(query, params)
 
@@ -137,11 +172,14 @@ func (c *categoryRepo) GetList(ctx context.Context, req *product_service.GetList
 
 	for rows.Next() {
 		var (
-			id         sql.NullString
-			name       sql.NullString
-			parent     sql.NullString
-			created_at sql.NullString
-			updated_at sql.NullString
+			id               sql.NullString
+			name             sql.NullString
+			parent           sql.NullString
+			created_at       sql.NullString
+			updated_at       sql.NullString
+			version          sql.NullInt64
+			tax_rate_id      sql.NullString
+			requires_marking sql.NullBool
 		)
 
 		err := rows.Scan(
@@ -151,17 +189,23 @@ func (c *categoryRepo) GetList(ctx context.Context, req *product_service.GetList
 			&parent,
 			&created_at,
 			&updated_at,
+			&version,
+			&tax_rate_id,
+			&requires_marking,
 		)
 		if err != nil {
 			return resp, err
 		}
 
 		resp.Categorys = append(resp.Categorys, &product_service.Category{
-			Id:        id.String,
-			Name:      name.String,
-			Parent:    parent.String,
-			CreatedAt: created_at.String,
-			UpdatedAt: updated_at.String,
+			Id:              id.String,
+			Name:            name.String,
+			Parent:          parent.String,
+			CreatedAt:       created_at.String,
+			UpdatedAt:       updated_at.String,
+			Version:         version.Int64,
+			TaxRateId:       tax_rate_id.String,
+			RequiresMarking: requires_marking.Bool,
 		})
 	}
 
@@ -180,23 +224,24 @@ func (c *categoryRepo) Update(ctx context.Context, req *product_service.UpdateCa
 		SET
 			name = :name,
 			parent= :parent,
+			tax_rate_id = :tax_rate_id,
+			requires_marking = :requires_marking,
+			version = version + 1,
 			updated_at = now()
-		WHERE id = :id
+		WHERE id = :id AND version = :version
 	`
 	params = map[string]interface{}{
-		"id":     req.GetId(),
-		"name":   req.GetName(),
-		"parent": req.GetParent(),
+		"id":               req.GetId(),
+		"name":             req.GetName(),
+		"parent":           req.GetParent(),
+		"version":          req.GetVersion(),
+		"tax_rate_id":      helper.NewNullString(req.GetTaxRateId()),
+		"requires_marking": req.GetRequiresMarking(),
 	}
 
 	query, args := helper.ReplaceQueryParams(query, params)
 
-	result, err := c.db.Exec(ctx, query, args...)
-	if err != nil {
-		return
-	}
-
-	return result.RowsAffected(), nil
+	return execAudited(ctx, c.db, models.AuditActionUpdate, query, args...)
 }
 
 func (c *categoryRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error) {
@@ -206,6 +251,8 @@ func (c *categoryRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchR
 		query string
 	)
 
+	delete(req.Fields, "version")
+
 	if len(req.Fields) == 0 {
 		err = errors.New("no updates provided")
 		return
@@ -221,31 +268,58 @@ func (c *categoryRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchR
 		ind++
 	}
 
+	req.Fields["version"] = req.Version
+
 	query = `
 		UPDATE
 			"category"
-	` + set + ` , updated_at = now()
+	` + set + ` , version = version + 1, updated_at = now()
 		WHERE
-			id = :id
+			id = :id AND version = :version
 	`
 
 	query, args := helper.ReplaceQueryParams(query, req.Fields)
 
-	result, err := c.db.Exec(ctx, query, args...)
-	if err != nil {
-		return
-	}
-
-	return result.RowsAffected(), err
+	return execAudited(ctx, c.db, models.AuditActionPatch, query, args...)
 }
 
 func (c *categoryRepo) Delete(ctx context.Context, req *product_service.CategoryPK) error {
 	query := `DELETE FROM "category" WHERE id = $1`
 
-	_, err := c.db.Exec(ctx, query, req.Id)
+	_, err := execAudited(ctx, c.db, models.AuditActionDelete, query, req.Id)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// categoryAsOf rebuilds the "category" relation from the history snapshots that were valid at the given moment
+func categoryAsOf(asOf string) string {
+	return tableAsOf("category", asOf) + ` AS "category"`
+}
+
+// categoryAncestors selects the id of the category given by the SQL expression and the ids of all the categories above it,
+// read from the categories relation, "category" or a tableAsOf snapshot of it
+func categoryAncestors(categories, categoryId string) string {
+	return `(
+			WITH RECURSIVE ancestors AS (
+				SELECT r.id, r.parent FROM ` + categories + ` r WHERE r.id = ` + categoryId + `
+				UNION
+				SELECT c.id, c.parent FROM ` + categories + ` c JOIN ancestors a ON c.id::TEXT = a.parent
+			)
+			SELECT id FROM ancestors
+		)`
+}
+
+// categoryDescendants selects the ids of the categories in the SQL array expression and of all the categories below them
+func categoryDescendants(categories, categoryIds string) string {
+	return `(
+			WITH RECURSIVE descendants AS (
+				SELECT r.id FROM ` + categories + ` r WHERE r.id = ANY(` + categoryIds + `)
+				UNION
+				SELECT c.id FROM ` + categories + ` c JOIN descendants d ON c.parent = d.id::TEXT
+			)
+			SELECT id FROM descendants
+		)`
+}
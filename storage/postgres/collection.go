@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const collectionColumns = `
+			id,
+			name,
+			rule,
+			created_at,
+			updated_at
+`
+
+type collectionRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCollectionRepo(db *pgxpool.Pool) *collectionRepo {
+	return &collectionRepo{
+		db: db,
+	}
+}
+
+func (c *collectionRepo) Create(ctx context.Context, req *product_service.CreateCollection) (resp *product_service.CollectionPK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "collection" (
+			id,
+			name,
+			rule,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, NOW(), NOW())
+	`
+
+	rule, err := marshalCollectionRule(req.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.Name,
+		rule,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.CollectionPK{Id: id}, nil
+}
+
+func (c *collectionRepo) GetByID(ctx context.Context, req *product_service.CollectionPK) (resp *product_service.Collection, err error) {
+	query := `
+		SELECT ` + collectionColumns + `
+		FROM "collection"
+		WHERE id = $1;
+	`
+
+	return scanCollection(c.db.QueryRow(ctx, query, req.Id))
+}
+
+func (c *collectionRepo) GetList(ctx context.Context, req *product_service.GetListCollectionRequest) (resp *product_service.GetListCollectionResponse, err error) {
+	resp = &product_service.GetListCollectionResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY name "
+	)
+
+	query = `
+	   SELECT
+	   		COUNT(*) OVER(), ` + collectionColumns + `
+		FROM "collection"
+	`
+	if len(req.GetSearch()) > 0 {
+		filter += " AND name ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		collection, err := scanCollection(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Collections = append(resp.Collections, collection)
+	}
+
+	return resp, rows.Err()
+}
+
+func (c *collectionRepo) Update(ctx context.Context, req *product_service.UpdateCollection) (resp int64, err error) {
+	query := `
+		UPDATE
+			"collection"
+		SET
+			name = $2,
+			rule = $3,
+			updated_at = now()
+		WHERE id = $1
+	`
+
+	rule, err := marshalCollectionRule(req.Rule)
+	if err != nil {
+		return
+	}
+
+	result, err := c.db.Exec(ctx, query, req.GetId(), req.GetName(), rule)
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *collectionRepo) Delete(ctx context.Context, req *product_service.CollectionPK) error {
+	query := `DELETE FROM "collection" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func marshalCollectionRule(rule *product_service.CollectionRule) ([]byte, error) {
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(rule)
+}
+
+func scanCollection(row pgx.Row, prefix ...interface{}) (*product_service.Collection, error) {
+	var (
+		id         sql.NullString
+		name       sql.NullString
+		rule       []byte
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	dest := append(prefix,
+		&id,
+		&name,
+		&rule,
+		&created_at,
+		&updated_at,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &product_service.Collection{
+		Id:        id.String,
+		Name:      name.String,
+		Rule:      &product_service.CollectionRule{},
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}
+
+	// rules saved by newer versions may carry conditions this one does not know
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(rule, resp.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
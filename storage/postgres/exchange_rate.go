@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type exchangeRateRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewExchangeRateRepo(db *pgxpool.Pool) *exchangeRateRepo {
+	return &exchangeRateRepo{
+		db: db,
+	}
+}
+
+// Set stores the rate of the currency from the effective date, a rate set again for the same date replaces it
+func (c *exchangeRateRepo) Set(ctx context.Context, req *product_service.SetExchangeRateRequest) (resp *product_service.ExchangeRate, err error) {
+	query := `
+		INSERT INTO "exchange_rate" (
+			id,
+			currency,
+			rate,
+			effective_from,
+			created_at
+		) VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (currency, effective_from) DO UPDATE SET
+			rate = EXCLUDED.rate
+		RETURNING id, currency, rate, effective_from, created_at
+	`
+	var (
+		id             sql.NullString
+		currency       sql.NullString
+		rate           sql.NullFloat64
+		effective_from sql.NullString
+		created_at     sql.NullString
+	)
+
+	err = c.db.QueryRow(
+		ctx,
+		query,
+		uuid.New().String(),
+		req.Currency,
+		req.Rate,
+		req.EffectiveFrom,
+	).Scan(
+		&id,
+		&currency,
+		&rate,
+		&effective_from,
+		&created_at,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ExchangeRate{
+		Id:            id.String,
+		Currency:      currency.String,
+		Rate:          rate.Float64,
+		EffectiveFrom: effective_from.String,
+		CreatedAt:     created_at.String,
+	}, nil
+}
+
+func (c *exchangeRateRepo) GetList(ctx context.Context, req *product_service.GetExchangeRatesRequest) (resp *product_service.GetExchangeRatesResponse, err error) {
+	resp = &product_service.GetExchangeRatesResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY effective_from DESC, currency "
+	)
+
+	query = `
+	   SELECT
+	   		COUNT(*) OVER(),
+			   id,
+			   currency,
+			   rate,
+			   effective_from,
+			   created_at
+		FROM "exchange_rate"
+	`
+	if len(req.GetCurrency()) > 0 {
+		filter += " AND currency = :currency "
+		params["currency"] = req.Currency
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id             sql.NullString
+			currency       sql.NullString
+			rate           sql.NullFloat64
+			effective_from sql.NullString
+			created_at     sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&currency,
+			&rate,
+			&effective_from,
+			&created_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Rates = append(resp.Rates, &product_service.ExchangeRate{
+			Id:            id.String,
+			Currency:      currency.String,
+			Rate:          rate.Float64,
+			EffectiveFrom: effective_from.String,
+			CreatedAt:     created_at.String,
+		})
+	}
+
+	return resp, rows.Err()
+}
+
+// GetRates returns the rate of every currency in effect at the moment, keyed by currency code
+func (c *exchangeRateRepo) GetRates(ctx context.Context, at time.Time) (resp map[string]float64, err error) {
+	resp = make(map[string]float64)
+
+	query := `
+		SELECT DISTINCT ON (currency)
+			currency,
+			rate
+		FROM "exchange_rate"
+		WHERE effective_from <= $1
+		ORDER BY currency, effective_from DESC
+	`
+
+	rows, err := c.db.Query(ctx, query, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			currency sql.NullString
+			rate     sql.NullFloat64
+		)
+
+		err := rows.Scan(&currency, &rate)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[currency.String] = rate.Float64
+	}
+
+	return resp, rows.Err()
+}
@@ -0,0 +1,510 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/models"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const goodsReceiptColumns = `
+			r.id,
+			r.provider_id,
+			r.magazin_id,
+			r.invoice_number,
+			r.status,
+			r.currency,
+			r.comment,
+			r.staff_id,
+			r.posted_by,
+			r.posted_at,
+			(SELECT COALESCE(SUM(l.quantity * l.cost_price), 0) FROM "goods_receipt_line" l WHERE l.receipt_id = r.id),
+			r.created_at,
+			r.updated_at,
+			r.purchase_order_id
+`
+
+// receiptCost is the average cost a posted receipt brings for one product
+type receiptCost struct {
+	productId string
+	cost      float64
+}
+
+type goodsReceiptRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewGoodsReceiptRepo(db *pgxpool.Pool) *goodsReceiptRepo {
+	return &goodsReceiptRepo{
+		db: db,
+	}
+}
+
+func (c *goodsReceiptRepo) Create(ctx context.Context, req *product_service.CreateGoodsReceipt) (resp *product_service.GoodsReceiptPK, err error) {
+	id := uuid.New().String()
+
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "goods_receipt" (
+			id,
+			provider_id,
+			magazin_id,
+			invoice_number,
+			status,
+			currency,
+			comment,
+			staff_id,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+	`,
+		id,
+		req.ProviderId,
+		req.MagazinId,
+		helper.NewNullString(req.InvoiceNumber),
+		config.DocumentStatusDraft,
+		req.Currency,
+		helper.NewNullString(req.Comment),
+		helper.NewNullString(req.StaffId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = insertGoodsReceiptLines(ctx, tx, id, req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.GoodsReceiptPK{Id: id}, tx.Commit(ctx)
+}
+
+func (c *goodsReceiptRepo) GetByID(ctx context.Context, req *product_service.GoodsReceiptPK) (resp *product_service.GoodsReceipt, err error) {
+	query := `
+		SELECT ` + goodsReceiptColumns + `
+		FROM "goods_receipt" r
+		WHERE r.id = $1
+	`
+
+	resp, err = scanGoodsReceipt(c.db.QueryRow(ctx, query, req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.Query(ctx, `
+		SELECT
+			l.id,
+			l.product_id,
+			p.name,
+			l.quantity,
+			l.cost_price,
+			TO_CHAR(l.expiry_date, 'YYYY-MM-DD')
+		FROM "goods_receipt_line" l
+		JOIN "product" p ON p.id = l.product_id
+		WHERE l.receipt_id = $1
+		ORDER BY l.position
+	`, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id           sql.NullString
+			product_id   sql.NullString
+			product_name sql.NullString
+			quantity     sql.NullFloat64
+			cost_price   sql.NullFloat64
+			expiry_date  sql.NullString
+		)
+
+		err := rows.Scan(&id, &product_id, &product_name, &quantity, &cost_price, &expiry_date)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Lines = append(resp.Lines, &product_service.GoodsReceiptLine{
+			Id:          id.String,
+			ProductId:   product_id.String,
+			ProductName: product_name.String,
+			Quantity:    quantity.Float64,
+			CostPrice:   float32(cost_price.Float64),
+			ExpiryDate:  expiry_date.String,
+		})
+	}
+
+	return resp, rows.Err()
+}
+
+func (c *goodsReceiptRepo) GetList(ctx context.Context, req *product_service.GetListGoodsReceiptRequest) (resp *product_service.GetListGoodsReceiptResponse, err error) {
+	resp = &product_service.GetListGoodsReceiptResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY r.created_at DESC "
+	)
+
+	query = `
+	   SELECT
+	   		COUNT(*) OVER(), ` + goodsReceiptColumns + `
+		FROM "goods_receipt" r
+	`
+	if len(req.GetProviderId()) > 0 {
+		filter += " AND r.provider_id = :provider_id "
+		params["provider_id"] = req.ProviderId
+	}
+	if len(req.GetMagazinId()) > 0 {
+		filter += " AND r.magazin_id = :magazin_id "
+		params["magazin_id"] = req.MagazinId
+	}
+	if len(req.GetStatus()) > 0 {
+		filter += " AND r.status = :status "
+		params["status"] = req.Status
+	}
+	if len(req.GetFromDate()) > 0 {
+		filter += " AND r.created_at >= :from_date "
+		params["from_date"] = req.FromDate
+	}
+	if len(req.GetToDate()) > 0 {
+		filter += " AND r.created_at <= :to_date "
+		params["to_date"] = req.ToDate
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		receipt, err := scanGoodsReceipt(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Receipts = append(resp.Receipts, receipt)
+	}
+
+	return resp, rows.Err()
+}
+
+// Update replaces the header and the lines of a draft receipt, posted receipts are left as they are
+func (c *goodsReceiptRepo) Update(ctx context.Context, req *product_service.UpdateGoodsReceipt) (rowsAffected int64, err error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE "goods_receipt"
+		SET
+			provider_id = $2,
+			magazin_id = $3,
+			invoice_number = $4,
+			currency = $5,
+			comment = $6,
+			updated_at = NOW()
+		WHERE id = $1 AND status = $7
+	`,
+		req.GetId(),
+		req.GetProviderId(),
+		req.GetMagazinId(),
+		helper.NewNullString(req.GetInvoiceNumber()),
+		req.GetCurrency(),
+		helper.NewNullString(req.GetComment()),
+		config.DocumentStatusDraft,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected = result.RowsAffected()
+	if rowsAffected == 0 {
+		return 0, nil
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM "goods_receipt_line" WHERE receipt_id = $1`, req.GetId())
+	if err != nil {
+		return 0, err
+	}
+
+	err = insertGoodsReceiptLines(ctx, tx, req.GetId(), req.GetLines())
+	if err != nil {
+		return 0, err
+	}
+
+	return rowsAffected, tx.Commit(ctx)
+}
+
+// Delete removes a draft receipt, posted receipts are part of the stock history and stay
+func (c *goodsReceiptRepo) Delete(ctx context.Context, req *product_service.GoodsReceiptPK) (rowsAffected int64, err error) {
+	query := `DELETE FROM "goods_receipt" WHERE id = $1 AND status = $2`
+
+	result, err := c.db.Exec(ctx, query, req.Id, config.DocumentStatusDraft)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// Post brings the goods of a draft receipt into stock in one transaction: the receipt becomes posted, every product
+// gets a receipt movement, and products with a known cost take it as their cost price and the cost of the provider
+func (c *goodsReceiptRepo) Post(ctx context.Context, req *product_service.PostGoodsReceiptRequest) (rowsAffected int64, err error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	posted, err := postGoodsReceipt(ctx, tx, req.GetId(), req.GetStaffId())
+	if err != nil || !posted {
+		return 0, err
+	}
+
+	return 1, tx.Commit(ctx)
+}
+
+// postGoodsReceipt posts a draft receipt inside the transaction and reports false when it is not a draft
+func postGoodsReceipt(ctx context.Context, tx pgx.Tx, id, staffId string) (bool, error) {
+	var (
+		provider_id sql.NullString
+		magazin_id  sql.NullString
+		currency    sql.NullString
+	)
+
+	err := tx.QueryRow(ctx, `
+		UPDATE "goods_receipt"
+		SET
+			status = $2,
+			posted_by = $3,
+			posted_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND status = $4
+		RETURNING provider_id, magazin_id, currency
+	`,
+		id,
+		config.DocumentStatusPosted,
+		helper.NewNullString(staffId),
+		config.DocumentStatusDraft,
+	).Scan(&provider_id, &magazin_id, &currency)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// repeated products are received once, at the average cost of their costed lines
+	rows, err := tx.Query(ctx, `
+		SELECT
+			product_id,
+			SUM(quantity),
+			COALESCE(SUM(quantity * cost_price) FILTER (WHERE cost_price > 0) / NULLIF(SUM(quantity) FILTER (WHERE cost_price > 0), 0), 0)
+		FROM "goods_receipt_line"
+		WHERE receipt_id = $1
+		GROUP BY product_id
+		ORDER BY product_id
+	`, id)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		movements []*product_service.StockMovement
+		// costs follow the product order of the query so that product rows are locked in the same order as stock rows
+		costs []receiptCost
+	)
+
+	for rows.Next() {
+		var (
+			product_id sql.NullString
+			quantity   sql.NullFloat64
+			cost_price sql.NullFloat64
+		)
+
+		err = rows.Scan(&product_id, &quantity, &cost_price)
+		if err != nil {
+			rows.Close()
+			return false, err
+		}
+
+		movements = append(movements, &product_service.StockMovement{
+			ProductId:  product_id.String,
+			MagazinId:  magazin_id.String,
+			Type:       config.MovementTypeReceipt,
+			Quantity:   quantity.Float64,
+			DocumentId: id,
+			StaffId:    staffId,
+			Comment:    "goods receipt",
+		})
+		if cost_price.Float64 > 0 {
+			costs = append(costs, receiptCost{productId: product_id.String, cost: cost_price.Float64})
+		}
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return false, err
+	}
+
+	_, err = recordMovements(ctx, tx, movements, true)
+	if err != nil {
+		return false, err
+	}
+
+	err = setAuditContext(ctx, tx, models.AuditActionReceipt)
+	if err != nil {
+		return false, err
+	}
+
+	for _, item := range costs {
+		_, err = tx.Exec(ctx, `
+			UPDATE "product"
+			SET
+				cost_price = $2,
+				cost_currency = $3,
+				version = version + 1,
+				updated_at = NOW()
+			WHERE id = $1
+		`, item.productId, item.cost, currency.String)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "product_provider_cost" (
+				id,
+				product_id,
+				provider_id,
+				cost_price,
+				currency,
+				created_at,
+				updated_at
+			) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+			ON CONFLICT (product_id, provider_id) DO UPDATE SET
+				cost_price = EXCLUDED.cost_price,
+				currency = EXCLUDED.currency,
+				updated_at = NOW()
+		`, uuid.New().String(), item.productId, provider_id.String, item.cost, currency.String)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func insertGoodsReceiptLines(ctx context.Context, tx pgx.Tx, receiptId string, lines []*product_service.GoodsReceiptLine) error {
+	for position, line := range lines {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO "goods_receipt_line" (
+				id,
+				receipt_id,
+				product_id,
+				quantity,
+				cost_price,
+				expiry_date,
+				position
+			) VALUES ($1, $2, $3, $4, $5, $6::DATE, $7)
+		`,
+			uuid.New().String(),
+			receiptId,
+			line.GetProductId(),
+			line.GetQuantity(),
+			line.GetCostPrice(),
+			helper.NewNullString(line.GetExpiryDate()),
+			position,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func scanGoodsReceipt(row pgx.Row, prefix ...interface{}) (*product_service.GoodsReceipt, error) {
+	var (
+		id             sql.NullString
+		provider_id    sql.NullString
+		magazin_id     sql.NullString
+		invoice_number sql.NullString
+		status         sql.NullString
+		currency       sql.NullString
+		comment        sql.NullString
+		staff_id       sql.NullString
+		posted_by      sql.NullString
+		posted_at      sql.NullString
+		total          sql.NullFloat64
+		created_at     sql.NullString
+		updated_at     sql.NullString
+		order_id       sql.NullString
+	)
+
+	dest := append(prefix,
+		&id,
+		&provider_id,
+		&magazin_id,
+		&invoice_number,
+		&status,
+		&currency,
+		&comment,
+		&staff_id,
+		&posted_by,
+		&posted_at,
+		&total,
+		&created_at,
+		&updated_at,
+		&order_id,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.GoodsReceipt{
+		Id:              id.String,
+		ProviderId:      provider_id.String,
+		MagazinId:       magazin_id.String,
+		InvoiceNumber:   invoice_number.String,
+		Status:          status.String,
+		Currency:        currency.String,
+		Comment:         comment.String,
+		StaffId:         staff_id.String,
+		PostedBy:        posted_by.String,
+		PostedAt:        posted_at.String,
+		Total:           float32(total.Float64),
+		CreatedAt:       created_at.String,
+		UpdatedAt:       updated_at.String,
+		PurchaseOrderId: order_id.String,
+	}, nil
+}
@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type manufacturerRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewManufacturerRepo(db *pgxpool.Pool) *manufacturerRepo {
+	return &manufacturerRepo{
+		db: db,
+	}
+}
+
+func (c *manufacturerRepo) Create(ctx context.Context, req *product_service.CreateManufacturer) (resp *product_service.ManufacturerPK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "manufacturer" (
+			id,
+			name,
+			country,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, NOW(), NOW())
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.Name,
+		req.Country,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ManufacturerPK{Id: id}, nil
+}
+
+func (c *manufacturerRepo) GetByID(ctx context.Context, req *product_service.ManufacturerPK) (resp *product_service.Manufacturer, err error) {
+	query := `
+		SELECT
+			id,
+			name,
+			country,
+			created_at,
+			updated_at
+		FROM "manufacturer"
+		WHERE id = $1;
+	`
+	var (
+		id         sql.NullString
+		name       sql.NullString
+		country    sql.NullString
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&name,
+		&country,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return resp, err
+	}
+
+	resp = &product_service.Manufacturer{
+		Id:        id.String,
+		Name:      name.String,
+		Country:   country.String,
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}
+
+	return
+}
+
+func (c *manufacturerRepo) GetList(ctx context.Context, req *product_service.GetListManufacturerRequest) (resp *product_service.GetListManufacturerResponse, err error) {
+	resp = &product_service.GetListManufacturerResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY name "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   name,
+			   country,
+			   created_at,
+			   updated_at
+		FROM "manufacturer"
+	`
+	if len(req.GetSearch()) > 0 {
+		filter += " AND name ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullString
+			name       sql.NullString
+			country    sql.NullString
+			created_at sql.NullString
+			updated_at sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&name,
+			&country,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Manufacturers = append(resp.Manufacturers, &product_service.Manufacturer{
+			Id:        id.String,
+			Name:      name.String,
+			Country:   country.String,
+			CreatedAt: created_at.String,
+			UpdatedAt: updated_at.String,
+		})
+	}
+
+	return
+}
+
+func (c *manufacturerRepo) Update(ctx context.Context, req *product_service.UpdateManufacturer) (resp int64, err error) {
+	query := `
+		UPDATE
+			"manufacturer"
+		SET
+			name = $2,
+			country = $3,
+			updated_at = now()
+		WHERE id = $1
+	`
+
+	result, err := c.db.Exec(ctx, query, req.GetId(), req.GetName(), req.GetCountry())
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *manufacturerRepo) Delete(ctx context.Context, req *product_service.ManufacturerPK) error {
+	query := `DELETE FROM "manufacturer" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
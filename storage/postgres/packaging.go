@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const packagingColumns = `
+			id,
+			product_id,
+			name,
+			barcode,
+			parent_id,
+			quantity,
+			created_at,
+			updated_at
+`
+
+type packagingRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPackagingRepo(db *pgxpool.Pool) *packagingRepo {
+	return &packagingRepo{
+		db: db,
+	}
+}
+
+// Set creates the packaging level, or replaces the existing one when the id is given
+func (c *packagingRepo) Set(ctx context.Context, req *product_service.SetPackagingRequest) (resp *product_service.PackagingPK, err error) {
+	id := req.GetId()
+	if len(id) == 0 {
+		id = uuid.New().String()
+	}
+
+	query := `
+		INSERT INTO "product_packaging" (
+			id,
+			product_id,
+			name,
+			barcode,
+			parent_id,
+			quantity,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			barcode = EXCLUDED.barcode,
+			parent_id = EXCLUDED.parent_id,
+			quantity = EXCLUDED.quantity,
+			updated_at = NOW()
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.ProductId,
+		req.Name,
+		helper.NewNullString(req.Barcode),
+		helper.NewNullString(req.ParentId),
+		req.Quantity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.PackagingPK{Id: id}, nil
+}
+
+func (c *packagingRepo) GetByID(ctx context.Context, req *product_service.PackagingPK) (resp *product_service.Packaging, err error) {
+	query := `
+		SELECT ` + packagingColumns + `
+		FROM "product_packaging"
+		WHERE id = $1;
+	`
+
+	return scanPackaging(c.db.QueryRow(ctx, query, req.Id))
+}
+
+func (c *packagingRepo) GetList(ctx context.Context, req *product_service.GetPackagingsRequest) (resp *product_service.GetPackagingsResponse, err error) {
+	resp = &product_service.GetPackagingsResponse{}
+
+	query := `
+		SELECT
+			COUNT(*) OVER(), ` + packagingColumns + `
+		FROM "product_packaging"
+		WHERE product_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := c.db.Query(ctx, query, req.GetProductId())
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		packaging, err := scanPackaging(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Packagings = append(resp.Packagings, packaging)
+	}
+
+	return resp, rows.Err()
+}
+
+func (c *packagingRepo) Delete(ctx context.Context, req *product_service.PackagingPK) error {
+	query := `DELETE FROM "product_packaging" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanPackaging(row pgx.Row, prefix ...interface{}) (*product_service.Packaging, error) {
+	var (
+		id         sql.NullString
+		product_id sql.NullString
+		name       sql.NullString
+		barcode    sql.NullString
+		parent_id  sql.NullString
+		quantity   sql.NullFloat64
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	dest := append(prefix,
+		&id,
+		&product_id,
+		&name,
+		&barcode,
+		&parent_id,
+		&quantity,
+		&created_at,
+		&updated_at,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.Packaging{
+		Id:        id.String,
+		ProductId: product_id.String,
+		Name:      name.String,
+		Barcode:   barcode.String,
+		ParentId:  parent_id.String,
+		Quantity:  quantity.Float64,
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}, nil
+}
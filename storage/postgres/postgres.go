@@ -6,13 +6,35 @@ import (
 	"product_service/config"
 	"product_service/storage"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type Store struct {
-	db       *pgxpool.Pool
-	product  storage.ProductRepoI
-	category storage.CategoryRepoI
+	db              *pgxpool.Pool
+	product         storage.ProductRepoI
+	category        storage.CategoryRepoI
+	audit           storage.AuditRepoI
+	price           storage.PriceRepoI
+	promotion       storage.PromotionRepoI
+	priceList       storage.PriceListRepoI
+	priceOverride   storage.PriceOverrideRepoI
+	providerCost    storage.ProviderCostRepoI
+	taxRate         storage.TaxRateRepoI
+	saleRestriction storage.SaleRestrictionRepoI
+	exchangeRate    storage.ExchangeRateRepoI
+	unit            storage.UnitRepoI
+	packaging       storage.PackagingRepoI
+	brand           storage.BrandRepoI
+	manufacturer    storage.ManufacturerRepoI
+	tag             storage.TagRepoI
+	collection      storage.CollectionRepoI
+	bundle          storage.BundleRepoI
+	productRelation storage.ProductRelationRepoI
+	productTemplate storage.ProductTemplateRepoI
+	stock           storage.StockRepoI
+	goodsReceipt    storage.GoodsReceiptRepoI
+	purchaseOrder   storage.PurchaseOrderRepoI
 }
 
 func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, error) {
@@ -30,15 +52,42 @@ func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, erro
 
 	config.MaxConns = cfg.PostgresMaxConnections
 
+	// currency_rate() converts the base currency one to one, it reads the base from the connection
+	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, `SELECT set_config('currency.base', $1, false)`, cfg.BaseCurrency)
+		return err
+	}
+
 	pool, err := pgxpool.ConnectConfig(ctx, config)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Store{
-		db:       pool,
-		product:  NewProductRepo(pool),
-		category: NewCategoryRepo(pool),
+		db:              pool,
+		product:         NewProductRepo(pool),
+		category:        NewCategoryRepo(pool),
+		audit:           NewAuditRepo(pool),
+		price:           NewPriceRepo(pool),
+		promotion:       NewPromotionRepo(pool),
+		priceList:       NewPriceListRepo(pool),
+		priceOverride:   NewPriceOverrideRepo(pool),
+		providerCost:    NewProviderCostRepo(pool),
+		taxRate:         NewTaxRateRepo(pool),
+		saleRestriction: NewSaleRestrictionRepo(pool),
+		exchangeRate:    NewExchangeRateRepo(pool),
+		unit:            NewUnitRepo(pool),
+		packaging:       NewPackagingRepo(pool),
+		brand:           NewBrandRepo(pool),
+		manufacturer:    NewManufacturerRepo(pool),
+		tag:             NewTagRepo(pool),
+		collection:      NewCollectionRepo(pool),
+		bundle:          NewBundleRepo(pool),
+		productRelation: NewProductRelationRepo(pool),
+		productTemplate: NewProductTemplateRepo(pool),
+		stock:           NewStockRepo(pool),
+		goodsReceipt:    NewGoodsReceiptRepo(pool),
+		purchaseOrder:   NewPurchaseOrderRepo(pool),
 	}, nil
 }
 
@@ -59,3 +108,150 @@ func (s *Store) Product() storage.ProductRepoI {
 	}
 	return s.product
 }
+
+func (s *Store) Audit() storage.AuditRepoI {
+	if s.audit == nil {
+		s.audit = NewAuditRepo(s.db)
+	}
+	return s.audit
+}
+
+func (s *Store) Price() storage.PriceRepoI {
+	if s.price == nil {
+		s.price = NewPriceRepo(s.db)
+	}
+	return s.price
+}
+
+func (s *Store) Promotion() storage.PromotionRepoI {
+	if s.promotion == nil {
+		s.promotion = NewPromotionRepo(s.db)
+	}
+	return s.promotion
+}
+
+func (s *Store) PriceList() storage.PriceListRepoI {
+	if s.priceList == nil {
+		s.priceList = NewPriceListRepo(s.db)
+	}
+	return s.priceList
+}
+
+func (s *Store) PriceOverride() storage.PriceOverrideRepoI {
+	if s.priceOverride == nil {
+		s.priceOverride = NewPriceOverrideRepo(s.db)
+	}
+	return s.priceOverride
+}
+
+func (s *Store) ProviderCost() storage.ProviderCostRepoI {
+	if s.providerCost == nil {
+		s.providerCost = NewProviderCostRepo(s.db)
+	}
+	return s.providerCost
+}
+
+func (s *Store) TaxRate() storage.TaxRateRepoI {
+	if s.taxRate == nil {
+		s.taxRate = NewTaxRateRepo(s.db)
+	}
+	return s.taxRate
+}
+
+func (s *Store) SaleRestriction() storage.SaleRestrictionRepoI {
+	if s.saleRestriction == nil {
+		s.saleRestriction = NewSaleRestrictionRepo(s.db)
+	}
+	return s.saleRestriction
+}
+
+func (s *Store) ExchangeRate() storage.ExchangeRateRepoI {
+	if s.exchangeRate == nil {
+		s.exchangeRate = NewExchangeRateRepo(s.db)
+	}
+	return s.exchangeRate
+}
+
+func (s *Store) Unit() storage.UnitRepoI {
+	if s.unit == nil {
+		s.unit = NewUnitRepo(s.db)
+	}
+	return s.unit
+}
+
+func (s *Store) Packaging() storage.PackagingRepoI {
+	if s.packaging == nil {
+		s.packaging = NewPackagingRepo(s.db)
+	}
+	return s.packaging
+}
+
+func (s *Store) Brand() storage.BrandRepoI {
+	if s.brand == nil {
+		s.brand = NewBrandRepo(s.db)
+	}
+	return s.brand
+}
+
+func (s *Store) Manufacturer() storage.ManufacturerRepoI {
+	if s.manufacturer == nil {
+		s.manufacturer = NewManufacturerRepo(s.db)
+	}
+	return s.manufacturer
+}
+
+func (s *Store) Tag() storage.TagRepoI {
+	if s.tag == nil {
+		s.tag = NewTagRepo(s.db)
+	}
+	return s.tag
+}
+
+func (s *Store) Collection() storage.CollectionRepoI {
+	if s.collection == nil {
+		s.collection = NewCollectionRepo(s.db)
+	}
+	return s.collection
+}
+
+func (s *Store) Bundle() storage.BundleRepoI {
+	if s.bundle == nil {
+		s.bundle = NewBundleRepo(s.db)
+	}
+	return s.bundle
+}
+
+func (s *Store) ProductRelation() storage.ProductRelationRepoI {
+	if s.productRelation == nil {
+		s.productRelation = NewProductRelationRepo(s.db)
+	}
+	return s.productRelation
+}
+
+func (s *Store) ProductTemplate() storage.ProductTemplateRepoI {
+	if s.productTemplate == nil {
+		s.productTemplate = NewProductTemplateRepo(s.db)
+	}
+	return s.productTemplate
+}
+
+func (s *Store) Stock() storage.StockRepoI {
+	if s.stock == nil {
+		s.stock = NewStockRepo(s.db)
+	}
+	return s.stock
+}
+
+func (s *Store) GoodsReceipt() storage.GoodsReceiptRepoI {
+	if s.goodsReceipt == nil {
+		s.goodsReceipt = NewGoodsReceiptRepo(s.db)
+	}
+	return s.goodsReceipt
+}
+
+func (s *Store) PurchaseOrder() storage.PurchaseOrderRepoI {
+	if s.purchaseOrder == nil {
+		s.purchaseOrder = NewPurchaseOrderRepo(s.db)
+	}
+	return s.purchaseOrder
+}
@@ -0,0 +1,307 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/models"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type priceRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPriceRepo(db *pgxpool.Pool) *priceRepo {
+	return &priceRepo{
+		db: db,
+	}
+}
+
+func (c *priceRepo) SchedulePriceChange(ctx context.Context, req *product_service.SchedulePriceChangeRequest) (resp *product_service.ScheduledPriceChange, err error) {
+	query := `
+		INSERT INTO "scheduled_price_change" (
+			id,
+			product_id,
+			price,
+			effective_from,
+			status,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING id, product_id, price, effective_from, status, applied_at, created_at
+	`
+
+	var (
+		id             sql.NullString
+		product_id     sql.NullString
+		price          sql.NullFloat64
+		effective_from sql.NullString
+		status         sql.NullString
+		applied_at     sql.NullString
+		created_at     sql.NullString
+	)
+
+	err = c.db.QueryRow(
+		ctx,
+		query,
+		uuid.New().String(),
+		req.ProductId,
+		req.Price,
+		req.EffectiveFrom,
+		config.PriceChangePending,
+	).Scan(
+		&id,
+		&product_id,
+		&price,
+		&effective_from,
+		&status,
+		&applied_at,
+		&created_at,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ScheduledPriceChange{
+		Id:            id.String,
+		ProductId:     product_id.String,
+		Price:         float32(price.Float64),
+		EffectiveFrom: effective_from.String,
+		Status:        status.String,
+		AppliedAt:     applied_at.String,
+		CreatedAt:     created_at.String,
+	}, nil
+}
+
+func (c *priceRepo) GetHistory(ctx context.Context, req *product_service.GetPriceHistoryRequest) (resp *product_service.GetPriceHistoryResponse, err error) {
+	resp = &product_service.GetPriceHistoryResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE product_id = :product_id "
+		sort   = " ORDER BY changed_at DESC, id DESC "
+	)
+
+	params["product_id"] = req.GetProductId()
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   product_id,
+			   old_price,
+			   new_price,
+			   changed_at
+		FROM "product_price_history"
+	`
+	if len(req.GetFrom()) > 0 {
+		filter += " AND changed_at >= :from "
+		params["from"] = req.From
+	}
+	if len(req.GetTo()) > 0 {
+		filter += " AND changed_at <= :to "
+		params["to"] = req.To
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullInt64
+			product_id sql.NullString
+			old_price  sql.NullFloat64
+			new_price  sql.NullFloat64
+			changed_at sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&product_id,
+			&old_price,
+			&new_price,
+			&changed_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.History = append(resp.History, &product_service.PriceHistory{
+			Id:        id.Int64,
+			ProductId: product_id.String,
+			OldPrice:  float32(old_price.Float64),
+			NewPrice:  float32(new_price.Float64),
+			ChangedAt: changed_at.String,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return resp, err
+	}
+
+	resp.Scheduled, err = c.getPending(ctx, req.GetProductId())
+	if err != nil {
+		return resp, err
+	}
+
+	return
+}
+
+func (c *priceRepo) getPending(ctx context.Context, productId string) (resp []*product_service.ScheduledPriceChange, err error) {
+	query := `
+		SELECT
+			id,
+			product_id,
+			price,
+			effective_from,
+			status,
+			applied_at,
+			created_at
+		FROM "scheduled_price_change"
+		WHERE product_id = $1 AND status = $2
+		ORDER BY effective_from
+	`
+
+	rows, err := c.db.Query(ctx, query, productId, config.PriceChangePending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id             sql.NullString
+			product_id     sql.NullString
+			price          sql.NullFloat64
+			effective_from sql.NullString
+			status         sql.NullString
+			applied_at     sql.NullString
+			created_at     sql.NullString
+		)
+
+		err := rows.Scan(
+			&id,
+			&product_id,
+			&price,
+			&effective_from,
+			&status,
+			&applied_at,
+			&created_at,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, &product_service.ScheduledPriceChange{
+			Id:            id.String,
+			ProductId:     product_id.String,
+			Price:         float32(price.Float64),
+			EffectiveFrom: effective_from.String,
+			Status:        status.String,
+			AppliedAt:     applied_at.String,
+			CreatedAt:     created_at.String,
+		})
+	}
+
+	return resp, rows.Err()
+}
+
+// ApplyDueChanges moves every pending change whose effective_from has passed into "product" within one transaction
+func (c *priceRepo) ApplyDueChanges(ctx context.Context) (applied int64, err error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = setAuditContext(ctx, tx, models.AuditActionSchedule)
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT
+			id,
+			product_id,
+			price
+		FROM "scheduled_price_change"
+		WHERE status = $1 AND effective_from <= NOW()
+		ORDER BY effective_from
+		FOR UPDATE SKIP LOCKED
+	`, config.PriceChangePending)
+	if err != nil {
+		return 0, err
+	}
+
+	type dueChange struct {
+		id        string
+		productId string
+		price     float64
+	}
+
+	var changes []dueChange
+	for rows.Next() {
+		var change dueChange
+		if err = rows.Scan(&change.id, &change.productId, &change.price); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		changes = append(changes, change)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, change := range changes {
+		_, err = tx.Exec(ctx, `
+			UPDATE "product"
+			SET
+				price = $2,
+				version = version + 1,
+				updated_at = now()
+			WHERE id = $1
+		`, change.productId, change.price)
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE "scheduled_price_change"
+			SET
+				status = $2,
+				applied_at = now()
+			WHERE id = $1
+		`, change.id, config.PriceChangeApplied)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return int64(len(changes)), nil
+}
@@ -0,0 +1,500 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type priceListRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPriceListRepo(db *pgxpool.Pool) *priceListRepo {
+	return &priceListRepo{
+		db: db,
+	}
+}
+
+func (c *priceListRepo) Create(ctx context.Context, req *product_service.CreatePriceList) (resp *product_service.PriceListPK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "price_list" (
+			id,
+			name,
+			code,
+			is_default,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, NOW(), NOW())
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.Name,
+		req.Code,
+		req.IsDefault,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.PriceListPK{Id: id}, nil
+}
+
+func (c *priceListRepo) GetByID(ctx context.Context, req *product_service.PriceListPK) (resp *product_service.PriceList, err error) {
+	query := `
+		SELECT
+			id,
+			name,
+			code,
+			is_default,
+			created_at,
+			updated_at
+		FROM "price_list"
+		WHERE id = $1;
+	`
+	var (
+		id         sql.NullString
+		name       sql.NullString
+		code       sql.NullString
+		is_default sql.NullBool
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&name,
+		&code,
+		&is_default,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return resp, err
+	}
+
+	resp = &product_service.PriceList{
+		Id:        id.String,
+		Name:      name.String,
+		Code:      code.String,
+		IsDefault: is_default.Bool,
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}
+
+	return
+}
+
+func (c *priceListRepo) GetList(ctx context.Context, req *product_service.GetListPriceListRequest) (resp *product_service.GetListPriceListResponse, err error) {
+	resp = &product_service.GetListPriceListResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY is_default DESC, created_at DESC "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   name,
+			   code,
+			   is_default,
+			   created_at,
+			   updated_at
+		FROM "price_list"
+	`
+	if len(req.GetSearch()) > 0 {
+		filter += " AND (name ILIKE '%' || :search || '%' OR code ILIKE '%' || :search || '%') "
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullString
+			name       sql.NullString
+			code       sql.NullString
+			is_default sql.NullBool
+			created_at sql.NullString
+			updated_at sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&name,
+			&code,
+			&is_default,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.PriceLists = append(resp.PriceLists, &product_service.PriceList{
+			Id:        id.String,
+			Name:      name.String,
+			Code:      code.String,
+			IsDefault: is_default.Bool,
+			CreatedAt: created_at.String,
+			UpdatedAt: updated_at.String,
+		})
+	}
+
+	return
+}
+
+func (c *priceListRepo) Update(ctx context.Context, req *product_service.UpdatePriceList) (resp int64, err error) {
+	query := `
+		UPDATE
+			"price_list"
+		SET
+			name = $2,
+			code = $3,
+			updated_at = now()
+		WHERE id = $1
+	`
+
+	result, err := c.db.Exec(ctx, query, req.GetId(), req.GetName(), req.GetCode())
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *priceListRepo) Delete(ctx context.Context, req *product_service.PriceListPK) error {
+	query := `DELETE FROM "price_list" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *priceListRepo) CreateItem(ctx context.Context, req *product_service.CreatePriceListItem) (resp *product_service.PriceListItemPK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "price_list_item" (
+			id,
+			price_list_id,
+			product_id,
+			min_quantity,
+			price,
+			valid_from,
+			valid_to,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.PriceListId,
+		req.ProductId,
+		req.MinQuantity,
+		req.Price,
+		helper.NewNullString(req.ValidFrom),
+		helper.NewNullString(req.ValidTo),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.PriceListItemPK{Id: id}, nil
+}
+
+func (c *priceListRepo) GetItemByID(ctx context.Context, req *product_service.PriceListItemPK) (resp *product_service.PriceListItem, err error) {
+	query := `
+		SELECT
+			id,
+			price_list_id,
+			product_id,
+			min_quantity,
+			price,
+			valid_from,
+			valid_to,
+			created_at,
+			updated_at
+		FROM "price_list_item"
+		WHERE id = $1;
+	`
+	var (
+		id            sql.NullString
+		price_list_id sql.NullString
+		product_id    sql.NullString
+		min_quantity  sql.NullFloat64
+		price         sql.NullFloat64
+		valid_from    sql.NullString
+		valid_to      sql.NullString
+		created_at    sql.NullString
+		updated_at    sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&price_list_id,
+		&product_id,
+		&min_quantity,
+		&price,
+		&valid_from,
+		&valid_to,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return resp, err
+	}
+
+	resp = &product_service.PriceListItem{
+		Id:          id.String,
+		PriceListId: price_list_id.String,
+		ProductId:   product_id.String,
+		MinQuantity: min_quantity.Float64,
+		Price:       float32(price.Float64),
+		ValidFrom:   valid_from.String,
+		ValidTo:     valid_to.String,
+		CreatedAt:   created_at.String,
+		UpdatedAt:   updated_at.String,
+	}
+
+	return
+}
+
+func (c *priceListRepo) GetItems(ctx context.Context, req *product_service.GetListPriceListItemRequest) (resp *product_service.GetListPriceListItemResponse, err error) {
+	resp = &product_service.GetListPriceListItemResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY product_id, min_quantity "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   price_list_id,
+			   product_id,
+			   min_quantity,
+			   price,
+			   valid_from,
+			   valid_to,
+			   created_at,
+			   updated_at
+		FROM "price_list_item"
+	`
+	if len(req.GetPriceListId()) > 0 {
+		filter += " AND price_list_id = :price_list_id "
+		params["price_list_id"] = req.PriceListId
+	}
+	if len(req.GetProductId()) > 0 {
+		filter += " AND product_id = :product_id "
+		params["product_id"] = req.ProductId
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id            sql.NullString
+			price_list_id sql.NullString
+			product_id    sql.NullString
+			min_quantity  sql.NullFloat64
+			price         sql.NullFloat64
+			valid_from    sql.NullString
+			valid_to      sql.NullString
+			created_at    sql.NullString
+			updated_at    sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&price_list_id,
+			&product_id,
+			&min_quantity,
+			&price,
+			&valid_from,
+			&valid_to,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Items = append(resp.Items, &product_service.PriceListItem{
+			Id:          id.String,
+			PriceListId: price_list_id.String,
+			ProductId:   product_id.String,
+			MinQuantity: min_quantity.Float64,
+			Price:       float32(price.Float64),
+			ValidFrom:   valid_from.String,
+			ValidTo:     valid_to.String,
+			CreatedAt:   created_at.String,
+			UpdatedAt:   updated_at.String,
+		})
+	}
+
+	return
+}
+
+func (c *priceListRepo) DeleteItem(ctx context.Context, req *product_service.PriceListItemPK) error {
+	query := `DELETE FROM "price_list_item" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPrice picks the deepest quantity tier valid at the date from the requested list, then from the default list,
+// and finally falls back to product.price which is the base tier of the default retail list, as it was at the date
+func (c *priceListRepo) GetPrice(ctx context.Context, req *product_service.GetPriceRequest) (resp *product_service.GetPriceResponse, err error) {
+	resp = &product_service.GetPriceResponse{
+		ProductId: req.GetProductId(),
+		Quantity:  req.GetQuantity(),
+	}
+
+	query := `
+		SELECT
+			i.price_list_id,
+			i.min_quantity,
+			i.price
+		FROM "price_list_item" i
+		JOIN "price_list" l ON l.id = i.price_list_id
+		WHERE i.product_id = $1
+			AND (i.price_list_id = $2 OR l.is_default)
+			AND i.min_quantity <= $3
+			AND (i.valid_from IS NULL OR i.valid_from <= COALESCE($4::timestamp, NOW()))
+			AND (i.valid_to IS NULL OR i.valid_to > COALESCE($4::timestamp, NOW()))
+		ORDER BY COALESCE(i.price_list_id = $2, FALSE) DESC, i.min_quantity DESC, i.valid_from DESC NULLS LAST
+		LIMIT 1
+	`
+
+	var (
+		price_list_id sql.NullString
+		min_quantity  sql.NullFloat64
+		price         sql.NullFloat64
+	)
+
+	err = c.db.QueryRow(
+		ctx,
+		query,
+		req.GetProductId(),
+		helper.NewNullString(req.GetPriceListId()),
+		req.GetQuantity(),
+		helper.NewNullString(req.GetDate()),
+	).Scan(
+		&price_list_id,
+		&min_quantity,
+		&price,
+	)
+	if err == nil {
+		resp.PriceListId = price_list_id.String
+		resp.MinQuantity = min_quantity.Float64
+		resp.UnitPrice = float32(price.Float64)
+		resp.Source = config.PriceSourcePriceList
+		return resp, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	query = `
+		SELECT
+			l.id,
+			p.price
+		FROM "product" p
+		LEFT JOIN "price_list" l ON l.is_default
+		WHERE p.id = $1
+	`
+	args := []interface{}{req.GetProductId()}
+
+	// a dated request takes the base price the product had at the date, there is none before the product existed
+	if len(req.GetDate()) > 0 {
+		query = `
+			SELECT
+				l.id,
+				h.new_price
+			FROM "product_price_history" h
+			LEFT JOIN "price_list" l ON l.is_default
+			WHERE h.product_id = $1 AND h.changed_at <= $2::timestamp
+			ORDER BY h.changed_at DESC, h.id DESC
+			LIMIT 1
+		`
+		args = append(args, req.GetDate())
+	}
+
+	err = c.db.QueryRow(ctx, query, args...).Scan(&price_list_id, &price)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.PriceListId = price_list_id.String
+	resp.MinQuantity = 1
+	resp.UnitPrice = float32(price.Float64)
+	resp.Source = config.PriceSourceBase
+
+	return resp, nil
+}
@@ -0,0 +1,269 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type priceOverrideRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPriceOverrideRepo(db *pgxpool.Pool) *priceOverrideRepo {
+	return &priceOverrideRepo{
+		db: db,
+	}
+}
+
+// Set creates the override for the filial or magazin, or replaces the price of the existing one
+func (c *priceOverrideRepo) Set(ctx context.Context, req *product_service.SetPriceOverrideRequest) (resp *product_service.PriceOverridePK, err error) {
+	conflict := "(product_id, filial_id)"
+	if len(req.GetMagazinId()) > 0 {
+		conflict = "(product_id, magazin_id)"
+	}
+
+	query := `
+		INSERT INTO "price_override" (
+			id,
+			product_id,
+			filial_id,
+			magazin_id,
+			price,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		ON CONFLICT ` + conflict + ` DO UPDATE SET
+			price = EXCLUDED.price,
+			updated_at = NOW()
+		RETURNING id
+	`
+
+	var id string
+
+	err = c.db.QueryRow(
+		ctx,
+		query,
+		uuid.New().String(),
+		req.ProductId,
+		helper.NewNullString(req.FilialId),
+		helper.NewNullString(req.MagazinId),
+		req.Price,
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.PriceOverridePK{Id: id}, nil
+}
+
+func (c *priceOverrideRepo) GetByID(ctx context.Context, req *product_service.PriceOverridePK) (resp *product_service.PriceOverride, err error) {
+	query := `
+		SELECT
+			id,
+			product_id,
+			filial_id,
+			magazin_id,
+			price,
+			created_at,
+			updated_at
+		FROM "price_override"
+		WHERE id = $1;
+	`
+	var (
+		id         sql.NullString
+		product_id sql.NullString
+		filial_id  sql.NullString
+		magazin_id sql.NullString
+		price      sql.NullFloat64
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&product_id,
+		&filial_id,
+		&magazin_id,
+		&price,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return resp, err
+	}
+
+	resp = &product_service.PriceOverride{
+		Id:        id.String,
+		ProductId: product_id.String,
+		FilialId:  filial_id.String,
+		MagazinId: magazin_id.String,
+		Price:     float32(price.Float64),
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}
+
+	return
+}
+
+func (c *priceOverrideRepo) GetList(ctx context.Context, req *product_service.GetPriceOverridesRequest) (resp *product_service.GetPriceOverridesResponse, err error) {
+	resp = &product_service.GetPriceOverridesResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY created_at DESC "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   product_id,
+			   filial_id,
+			   magazin_id,
+			   price,
+			   created_at,
+			   updated_at
+		FROM "price_override"
+	`
+	if len(req.GetProductId()) > 0 {
+		filter += " AND product_id = :product_id "
+		params["product_id"] = req.ProductId
+	}
+	if len(req.GetFilialId()) > 0 {
+		filter += " AND filial_id = :filial_id "
+		params["filial_id"] = req.FilialId
+	}
+	if len(req.GetMagazinId()) > 0 {
+		filter += " AND magazin_id = :magazin_id "
+		params["magazin_id"] = req.MagazinId
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullString
+			product_id sql.NullString
+			filial_id  sql.NullString
+			magazin_id sql.NullString
+			price      sql.NullFloat64
+			created_at sql.NullString
+			updated_at sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&product_id,
+			&filial_id,
+			&magazin_id,
+			&price,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Overrides = append(resp.Overrides, &product_service.PriceOverride{
+			Id:        id.String,
+			ProductId: product_id.String,
+			FilialId:  filial_id.String,
+			MagazinId: magazin_id.String,
+			Price:     float32(price.Float64),
+			CreatedAt: created_at.String,
+			UpdatedAt: updated_at.String,
+		})
+	}
+
+	return
+}
+
+// GetEffective returns the override that applies to every product keyed by product id, a magazin override wins over a filial one
+func (c *priceOverrideRepo) GetEffective(ctx context.Context, productIds []string, filialId, magazinId string) (resp map[string]*product_service.PriceOverride, err error) {
+	resp = make(map[string]*product_service.PriceOverride)
+
+	query := `
+		SELECT DISTINCT ON (product_id)
+			id,
+			product_id,
+			filial_id,
+			magazin_id,
+			price
+		FROM "price_override"
+		WHERE product_id = ANY($1) AND (magazin_id = $2 OR filial_id = $3)
+		ORDER BY product_id, (magazin_id IS NOT NULL) DESC
+	`
+
+	rows, err := c.db.Query(ctx, query, productIds, helper.NewNullString(magazinId), helper.NewNullString(filialId))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullString
+			product_id sql.NullString
+			filial_id  sql.NullString
+			magazin_id sql.NullString
+			price      sql.NullFloat64
+		)
+
+		err := rows.Scan(
+			&id,
+			&product_id,
+			&filial_id,
+			&magazin_id,
+			&price,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[product_id.String] = &product_service.PriceOverride{
+			Id:        id.String,
+			ProductId: product_id.String,
+			FilialId:  filial_id.String,
+			MagazinId: magazin_id.String,
+			Price:     float32(price.Float64),
+		}
+	}
+
+	return resp, rows.Err()
+}
+
+func (c *priceOverrideRepo) Delete(ctx context.Context, req *product_service.PriceOverridePK) error {
+	query := `DELETE FROM "price_override" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
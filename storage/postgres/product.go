@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"product_service/config"
 	"product_service/genproto/product_service"
 	"product_service/models"
 	"product_service/pkg/helper"
@@ -26,31 +27,7 @@ func NewProductRepo(db *pgxpool.Pool) *productRepo {
 func (c *productRepo) Create(ctx context.Context, req *product_service.CreateProduct) (resp *product_service.ProductPK, err error) {
 	id := uuid.New().String()
 
-	barcode := helper.GenerateBarcode(9)
-
-	query := `
-		INSERT INTO "product" (
-			id,
-			photo,
-			name,
-			category_id,
-			barcode,
-			price,
-			created_at,
-			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
-	`
-
-	_, err = c.db.Exec(
-		ctx,
-		query,
-		id,
-		req.Photo,
-		req.Name,
-		req.CategoryId,
-		barcode,
-		req.Price,
-	)
+	_, err = execAudited(ctx, c.db, models.AuditActionCreate, productInsert, productInsertArgs(id, req)...)
 	if err != nil {
 		fmt.Println(err)
 		return nil, err
@@ -59,7 +36,130 @@ func (c *productRepo) Create(ctx context.Context, req *product_service.CreatePro
 	return &product_service.ProductPK{Id: id}, nil
 }
 
+// Clone creates the product and copies the tags, relations and bundle components of the source onto it in one transaction
+func (c *productRepo) Clone(ctx context.Context, sourceId string, req *product_service.CreateProduct) (resp *product_service.ProductPK, err error) {
+	id := uuid.New().String()
+
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = setAuditContext(ctx, tx, models.AuditActionCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, productInsert, productInsertArgs(id, req)...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "product_tag" (product_id, tag_id, created_at)
+		SELECT $1, tag_id, NOW() FROM "product_tag" WHERE product_id = $2
+	`, id, sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT related_id, type, position FROM "product_relation" WHERE product_id = $1 ORDER BY position
+	`, sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var relations []*product_service.SetProductRelationRequest
+	for rows.Next() {
+		var (
+			related_id sql.NullString
+			typ        sql.NullString
+			position   sql.NullInt32
+		)
+
+		if err = rows.Scan(&related_id, &typ, &position); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		relations = append(relations, &product_service.SetProductRelationRequest{
+			RelatedId: related_id.String,
+			Type:      typ.String,
+			Position:  position.Int32,
+		})
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, relation := range relations {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "product_relation" (
+				id,
+				product_id,
+				related_id,
+				type,
+				position,
+				created_at,
+				updated_at
+			) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		`, uuid.New().String(), id, relation.RelatedId, relation.Type, relation.Position)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the components are locked as in SetBundle, so none of them turns into a bundle while the clone takes it
+	_, err = tx.Exec(ctx, `
+		SELECT 1 FROM "product"
+		WHERE id IN (SELECT component_id FROM "bundle_component" WHERE bundle_id = $1)
+		ORDER BY id
+		FOR SHARE
+	`, sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE "product" p
+		SET
+			is_bundle = s.is_bundle,
+			bundle_pricing = s.bundle_pricing,
+			bundle_discount = s.bundle_discount
+		FROM "product" s
+		WHERE p.id = $1 AND s.id = $2 AND s.is_bundle
+	`, id, sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "bundle_component" (bundle_id, component_id, quantity, created_at)
+		SELECT $1, component_id, quantity, NOW() FROM "bundle_component" WHERE bundle_id = $2
+	`, id, sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ProductPK{Id: id}, tx.Commit(ctx)
+}
+
 func (c *productRepo) GetByID(ctx context.Context, req *product_service.ProductPK) (order *product_service.Product, err error) {
+	var (
+		from = `"product"`
+		asOf = ""
+		args = []interface{}{req.Id}
+	)
+
+	if len(req.GetAsOf()) > 0 {
+		asOf = "$2"
+		from = productAsOf(asOf)
+		args = append(args, req.AsOf)
+	}
+
 	query := `
 		SELECT 
 			id,
@@ -69,22 +169,78 @@ func (c *productRepo) GetByID(ctx context.Context, req *product_service.ProductP
 			barcode,
 			price,
 			created_at,
-			updated_at
-		FROM "product"
+			updated_at,
+			version,
+			cost_price,
+			tax_rate_id,
+			mxik_code,
+			package_code,
+			price_excludes_vat,
+			` + productVatRate(asOf) + `,
+			requires_marking,
+			` + productMarkingRequired(asOf) + `,
+			currency,
+			cost_currency,
+			unit_id,
+			brand_id,
+			manufacturer_id,
+			` + productTagIds + `,
+			active,
+			is_bundle,
+			bundle_pricing,
+			bundle_discount,
+			net_weight,
+			gross_weight,
+			weight_unit,
+			length,
+			width,
+			height,
+			dimension_unit,
+			temperature_class,
+			gtin
+		FROM ` + from + `
 		WHERE id = $1;
 	`
 	var (
-		id          sql.NullString
-		photo       sql.NullString
-		name        sql.NullString
-		category_id sql.NullString
-		barcode     sql.NullString
-		price       sql.NullFloat64
-		created_at  sql.NullString
-		updated_at  sql.NullString
+		id                sql.NullString
+		photo             sql.NullString
+		name              sql.NullString
+		category_id       sql.NullString
+		barcode           sql.NullString
+		price             sql.NullFloat64
+		created_at        sql.NullString
+		updated_at        sql.NullString
+		version           sql.NullInt64
+		cost_price        sql.NullFloat64
+		tax_rate_id       sql.NullString
+		mxik_code         sql.NullString
+		package_code      sql.NullString
+		excl_vat          sql.NullBool
+		vat_rate          sql.NullFloat64
+		requires_marking  sql.NullBool
+		marking_required  sql.NullBool
+		currency          sql.NullString
+		cost_currency     sql.NullString
+		unit_id           sql.NullString
+		brand_id          sql.NullString
+		manufacturer_id   sql.NullString
+		tag_ids           []string
+		active            sql.NullBool
+		is_bundle         sql.NullBool
+		bundle_pricing    sql.NullString
+		bundle_discount   sql.NullFloat64
+		net_weight        sql.NullFloat64
+		gross_weight      sql.NullFloat64
+		weight_unit       sql.NullString
+		length            sql.NullFloat64
+		width             sql.NullFloat64
+		height            sql.NullFloat64
+		dimension_unit    sql.NullString
+		temperature_class sql.NullString
+		gtin              sql.NullString
 	)
 
-	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+	err = c.db.QueryRow(ctx, query, args...).Scan(
 		&id,
 		&photo,
 		&name,
@@ -93,20 +249,81 @@ func (c *productRepo) GetByID(ctx context.Context, req *product_service.ProductP
 		&price,
 		&created_at,
 		&updated_at,
+		&version,
+		&cost_price,
+		&tax_rate_id,
+		&mxik_code,
+		&package_code,
+		&excl_vat,
+		&vat_rate,
+		&requires_marking,
+		&marking_required,
+		&currency,
+		&cost_currency,
+		&unit_id,
+		&brand_id,
+		&manufacturer_id,
+		&tag_ids,
+		&active,
+		&is_bundle,
+		&bundle_pricing,
+		&bundle_discount,
+		&net_weight,
+		&gross_weight,
+		&weight_unit,
+		&length,
+		&width,
+		&height,
+		&dimension_unit,
+		&temperature_class,
+		&gtin,
 	)
 	if err != nil {
 		return order, err
 	}
 
 	order = &product_service.Product{
-		Id:         id.String,
-		Photo:      photo.String,
-		Name:       name.String,
-		CategoryId: category_id.String,
-		Barcode:    barcode.String,
-		Price:      float32(price.Float64),
-		CreatedAt:  created_at.String,
-		UpdatedAt:  updated_at.String,
+		Id:               id.String,
+		Photo:            photo.String,
+		Name:             name.String,
+		CategoryId:       category_id.String,
+		Barcode:          barcode.String,
+		Price:            float32(price.Float64),
+		CreatedAt:        created_at.String,
+		UpdatedAt:        updated_at.String,
+		Version:          version.Int64,
+		EffectivePrice:   float32(price.Float64),
+		PriceSource:      config.PriceSourceBase,
+		CostPrice:        float32(cost_price.Float64),
+		Margin:           float32(helper.MarginPercent(price.Float64, cost_price.Float64)),
+		Markup:           float32(helper.MarkupPercent(price.Float64, cost_price.Float64)),
+		TaxRateId:        tax_rate_id.String,
+		MxikCode:         mxik_code.String,
+		PackageCode:      package_code.String,
+		PriceExcludesVat: excl_vat.Bool,
+		VatRate:          float32(vat_rate.Float64),
+		RequiresMarking:  requires_marking.Bool,
+		MarkingRequired:  marking_required.Bool,
+		Currency:         currency.String,
+		CostCurrency:     cost_currency.String,
+		UnitId:           unit_id.String,
+		BrandId:          brand_id.String,
+		ManufacturerId:   manufacturer_id.String,
+		TagIds:           tag_ids,
+		Active:           active.Bool,
+		IsBundle:         is_bundle.Bool,
+		BundlePricing:    bundle_pricing.String,
+		BundleDiscount:   float32(bundle_discount.Float64),
+		NetWeight:        net_weight.Float64,
+		GrossWeight:      gross_weight.Float64,
+		WeightUnit:       weight_unit.String,
+		Length:           length.Float64,
+		Width:            width.Float64,
+		Height:           height.Float64,
+		DimensionUnit:    dimension_unit.String,
+		TemperatureClass: temperature_class.String,
+		Volume:           helper.VolumeM3(length.Float64, width.Float64, height.Float64, dimension_unit.String),
+		Gtin:             gtin.String,
 	}
 
 	return
@@ -121,9 +338,17 @@ func (c *productRepo) GetList(ctx context.Context, req *product_service.GetListP
 		offset = " OFFSET 0 "
 		params = make(map[string]interface{})
 		filter = " WHERE TRUE "
+		from   = `"product"`
+		asOf   = ""
 		sort   = " ORDER BY created_at DESC"
 	)
 
+	if len(req.GetAsOf()) > 0 {
+		asOf = ":as_of"
+		from = productAsOf(asOf)
+		params["as_of"] = req.AsOf
+	}
+
 	query = `
 	   SELECT 
 	   		COUNT(*) OVER(),
@@ -134,11 +359,97 @@ func (c *productRepo) GetList(ctx context.Context, req *product_service.GetListP
 			    barcode,
 			    price,
 			    created_at,
-			    updated_at
-		FROM "product"
-	`
+			    updated_at,
+			    version,
+			    cost_price,
+			    tax_rate_id,
+			    mxik_code,
+			    package_code,
+			    price_excludes_vat,
+			    ` + productVatRate(asOf) + `,
+			    requires_marking,
+			    ` + productMarkingRequired(asOf) + `,
+			    currency,
+			    cost_currency,
+			    unit_id,
+			    brand_id,
+			    manufacturer_id,
+			    ` + productTagIds + `,
+			    active,
+			    is_bundle,
+			    bundle_pricing,
+			    bundle_discount,
+			    net_weight,
+			    gross_weight,
+			    weight_unit,
+			    length,
+			    width,
+			    height,
+			    dimension_unit,
+			    temperature_class,
+			    gtin
+		FROM `
 	if len(req.GetSearch()) > 0 {
-		filter += " AND name ILIKE '%' || '" + req.Search + "' || '%' "
+		filter += " AND name ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
+	}
+	if len(req.GetCategoryIds()) > 0 && req.GetWithSubcategories() {
+		filter += " AND category_id IN " + categoryDescendants(`"category"`, ":category_ids") + " "
+		params["category_ids"] = req.CategoryIds
+	} else if len(req.GetCategoryIds()) > 0 {
+		filter += " AND category_id = ANY(:category_ids) "
+		params["category_ids"] = req.CategoryIds
+	}
+	// the price range is in the base currency, prices in other currencies are converted at the rate of the moment read
+	rateAt := "NOW()::timestamp"
+	if len(asOf) > 0 {
+		rateAt = asOf + "::timestamp"
+	}
+	if req.GetMinPrice() > 0 {
+		filter += " AND price * currency_rate(currency, " + rateAt + ") >= :min_price "
+		params["min_price"] = req.MinPrice
+	}
+	if req.GetMaxPrice() > 0 {
+		filter += " AND price * currency_rate(currency, " + rateAt + ") <= :max_price "
+		params["max_price"] = req.MaxPrice
+	}
+	if len(req.GetTemperatureClass()) > 0 {
+		filter += " AND temperature_class = :temperature_class "
+		params["temperature_class"] = req.TemperatureClass
+	}
+	if req.GetMinWeight() > 0 {
+		filter += " AND " + productGrossWeightKg + " >= :min_weight "
+		params["min_weight"] = req.MinWeight
+	}
+	if req.GetMaxWeight() > 0 {
+		filter += " AND " + productGrossWeightKg + " <= :max_weight "
+		params["max_weight"] = req.MaxWeight
+	}
+	if len(req.GetManufacturerId()) > 0 {
+		filter += " AND manufacturer_id = :manufacturer_id "
+		params["manufacturer_id"] = req.ManufacturerId
+	}
+	if len(req.GetTagIds()) > 0 {
+		if req.GetTagMatch() == config.TagMatchAll {
+			filter += ` AND (SELECT COUNT(DISTINCT pt.tag_id) FROM "product_tag" pt WHERE pt.product_id = "product".id AND pt.tag_id = ANY(:tag_ids)) = :tag_count `
+			params["tag_count"] = len(helper.UniqueStrings(req.TagIds))
+		} else {
+			filter += ` AND EXISTS (SELECT 1 FROM "product_tag" pt WHERE pt.product_id = "product".id AND pt.tag_id = ANY(:tag_ids)) `
+		}
+		params["tag_ids"] = req.TagIds
+	}
+
+	// facets count every brand under the other filters, so the brand filter itself is left out of them
+	if req.GetWithFacets() {
+		resp.BrandFacets, err = c.getBrandFacets(ctx, from, filter, params)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	if len(req.GetBrandIds()) > 0 {
+		filter += " AND brand_id = ANY(:brand_ids) "
+		params["brand_ids"] = req.BrandIds
 	}
 	if req.GetLimit() > 0 {
 		limit = " LIMIT :limit"
@@ -148,7 +459,7 @@ func (c *productRepo) GetList(ctx context.Context, req *product_service.GetListP
 		offset = " OFFSET :offset"
 		params["offset"] = req.Offset
 	}
-	query += filter + sort + offset + limit
+	query += from + filter + sort + offset + limit
 
 	query, args := helper.ReplaceQueryParams(query, params)
 
@@ -160,14 +471,42 @@ func (c *productRepo) GetList(ctx context.Context, req *product_service.GetListP
 
 	for rows.Next() {
 		var (
-			id          sql.NullString
-			photo       sql.NullString
-			name        sql.NullString
-			category_id sql.NullString
-			barcode     sql.NullString
-			price       sql.NullFloat64
-			created_at  sql.NullString
-			updated_at  sql.NullString
+			id                sql.NullString
+			photo             sql.NullString
+			name              sql.NullString
+			category_id       sql.NullString
+			barcode           sql.NullString
+			price             sql.NullFloat64
+			created_at        sql.NullString
+			updated_at        sql.NullString
+			version           sql.NullInt64
+			cost_price        sql.NullFloat64
+			tax_rate_id       sql.NullString
+			mxik_code         sql.NullString
+			package_code      sql.NullString
+			excl_vat          sql.NullBool
+			vat_rate          sql.NullFloat64
+			requires_marking  sql.NullBool
+			marking_required  sql.NullBool
+			currency          sql.NullString
+			cost_currency     sql.NullString
+			unit_id           sql.NullString
+			brand_id          sql.NullString
+			manufacturer_id   sql.NullString
+			tag_ids           []string
+			active            sql.NullBool
+			is_bundle         sql.NullBool
+			bundle_pricing    sql.NullString
+			bundle_discount   sql.NullFloat64
+			net_weight        sql.NullFloat64
+			gross_weight      sql.NullFloat64
+			weight_unit       sql.NullString
+			length            sql.NullFloat64
+			width             sql.NullFloat64
+			height            sql.NullFloat64
+			dimension_unit    sql.NullString
+			temperature_class sql.NullString
+			gtin              sql.NullString
 		)
 
 		err := rows.Scan(
@@ -180,20 +519,81 @@ func (c *productRepo) GetList(ctx context.Context, req *product_service.GetListP
 			&price,
 			&created_at,
 			&updated_at,
+			&version,
+			&cost_price,
+			&tax_rate_id,
+			&mxik_code,
+			&package_code,
+			&excl_vat,
+			&vat_rate,
+			&requires_marking,
+			&marking_required,
+			&currency,
+			&cost_currency,
+			&unit_id,
+			&brand_id,
+			&manufacturer_id,
+			&tag_ids,
+			&active,
+			&is_bundle,
+			&bundle_pricing,
+			&bundle_discount,
+			&net_weight,
+			&gross_weight,
+			&weight_unit,
+			&length,
+			&width,
+			&height,
+			&dimension_unit,
+			&temperature_class,
+			&gtin,
 		)
 		if err != nil {
 			return resp, err
 		}
 
 		resp.Products = append(resp.Products, &product_service.Product{
-			Id:         id.String,
-			Photo:      photo.String,
-			Name:       name.String,
-			CategoryId: category_id.String,
-			Barcode:    barcode.String,
-			Price:      float32(price.Float64),
-			CreatedAt:  created_at.String,
-			UpdatedAt:  updated_at.String,
+			Id:               id.String,
+			Photo:            photo.String,
+			Name:             name.String,
+			CategoryId:       category_id.String,
+			Barcode:          barcode.String,
+			Price:            float32(price.Float64),
+			CreatedAt:        created_at.String,
+			UpdatedAt:        updated_at.String,
+			Version:          version.Int64,
+			EffectivePrice:   float32(price.Float64),
+			PriceSource:      config.PriceSourceBase,
+			CostPrice:        float32(cost_price.Float64),
+			Margin:           float32(helper.MarginPercent(price.Float64, cost_price.Float64)),
+			Markup:           float32(helper.MarkupPercent(price.Float64, cost_price.Float64)),
+			TaxRateId:        tax_rate_id.String,
+			MxikCode:         mxik_code.String,
+			PackageCode:      package_code.String,
+			PriceExcludesVat: excl_vat.Bool,
+			VatRate:          float32(vat_rate.Float64),
+			RequiresMarking:  requires_marking.Bool,
+			MarkingRequired:  marking_required.Bool,
+			Currency:         currency.String,
+			CostCurrency:     cost_currency.String,
+			UnitId:           unit_id.String,
+			BrandId:          brand_id.String,
+			ManufacturerId:   manufacturer_id.String,
+			TagIds:           tag_ids,
+			Active:           active.Bool,
+			IsBundle:         is_bundle.Bool,
+			BundlePricing:    bundle_pricing.String,
+			BundleDiscount:   float32(bundle_discount.Float64),
+			NetWeight:        net_weight.Float64,
+			GrossWeight:      gross_weight.Float64,
+			WeightUnit:       weight_unit.String,
+			Length:           length.Float64,
+			Width:            width.Float64,
+			Height:           height.Float64,
+			DimensionUnit:    dimension_unit.String,
+			TemperatureClass: temperature_class.String,
+			Volume:           helper.VolumeM3(length.Float64, width.Float64, height.Float64, dimension_unit.String),
+			Gtin:             gtin.String,
 		})
 	}
 
@@ -206,8 +606,6 @@ func (c *productRepo) Update(ctx context.Context, req *product_service.UpdatePro
 		params map[string]interface{}
 	)
 
-	barcode := helper.GenerateBarcode(9)
-
 	query = `
 		UPDATE
 			"product"
@@ -215,28 +613,105 @@ func (c *productRepo) Update(ctx context.Context, req *product_service.UpdatePro
 			photo = :photo,
 			name= :name,
 			category_id = :category_id,
-			barcode = :barcode,
+			barcode = COALESCE(:barcode, barcode),
+			gtin = COALESCE(:gtin, gtin),
 			price = :price,
+			cost_price = :cost_price,
+			tax_rate_id = :tax_rate_id,
+			mxik_code = :mxik_code,
+			package_code = :package_code,
+			price_excludes_vat = :price_excludes_vat,
+			requires_marking = :requires_marking,
+			currency = :currency,
+			cost_currency = :cost_currency,
+			unit_id = :unit_id,
+			brand_id = :brand_id,
+			manufacturer_id = :manufacturer_id,
+			net_weight = :net_weight,
+			gross_weight = :gross_weight,
+			weight_unit = :weight_unit,
+			length = :length,
+			width = :width,
+			height = :height,
+			dimension_unit = :dimension_unit,
+			temperature_class = :temperature_class,
+			version = version + 1,
 			updated_at = now()
-		WHERE id = :id
+		WHERE id = :id AND version = :version
 	`
 	params = map[string]interface{}{
-		"id":          req.GetId(),
-		"photo":       req.GetPhoto(),
-		"name":        req.GetName(),
-		"category_id": req.GetCategoryId(),
-		"barcode":     barcode,
-		"price":       req.GetPrice(),
+		"id":                 req.GetId(),
+		"photo":              req.GetPhoto(),
+		"name":               req.GetName(),
+		"category_id":        req.GetCategoryId(),
+		"barcode":            helper.NewNullString(req.GetBarcode()),
+		"gtin":               helper.NewNullString(req.GetGtin()),
+		"price":              req.GetPrice(),
+		"cost_price":         req.GetCostPrice(),
+		"version":            req.GetVersion(),
+		"tax_rate_id":        helper.NewNullString(req.GetTaxRateId()),
+		"mxik_code":          helper.NewNullString(req.GetMxikCode()),
+		"package_code":       helper.NewNullString(req.GetPackageCode()),
+		"price_excludes_vat": req.GetPriceExcludesVat(),
+		"requires_marking":   req.GetRequiresMarking(),
+		"currency":           req.GetCurrency(),
+		"cost_currency":      req.GetCostCurrency(),
+		"unit_id":            req.GetUnitId(),
+		"brand_id":           helper.NewNullString(req.GetBrandId()),
+		"manufacturer_id":    helper.NewNullString(req.GetManufacturerId()),
+		"net_weight":         req.GetNetWeight(),
+		"gross_weight":       req.GetGrossWeight(),
+		"weight_unit":        req.GetWeightUnit(),
+		"length":             req.GetLength(),
+		"width":              req.GetWidth(),
+		"height":             req.GetHeight(),
+		"dimension_unit":     req.GetDimensionUnit(),
+		"temperature_class":  helper.NewNullString(req.GetTemperatureClass()),
 	}
 
 	query, args := helper.ReplaceQueryParams(query, params)
 
-	result, err := c.db.Exec(ctx, query, args...)
-	if err != nil {
-		return
+	return execAudited(ctx, c.db, models.AuditActionUpdate, query, args...)
+}
+
+// productPatchColumns are the columns UpdatePatch may write, keys are matched exactly because they go into SET unquoted
+var productPatchColumns = map[string]bool{
+	"photo":              true,
+	"name":               true,
+	"category_id":        true,
+	"barcode":            true,
+	"gtin":               true,
+	"price":              true,
+	"cost_price":         true,
+	"tax_rate_id":        true,
+	"mxik_code":          true,
+	"package_code":       true,
+	"price_excludes_vat": true,
+	"requires_marking":   true,
+	"currency":           true,
+	"cost_currency":      true,
+	"unit_id":            true,
+	"brand_id":           true,
+	"manufacturer_id":    true,
+	"net_weight":         true,
+	"gross_weight":       true,
+	"weight_unit":        true,
+	"length":             true,
+	"width":              true,
+	"height":             true,
+	"dimension_unit":     true,
+	"temperature_class":  true,
+}
+
+// checkPatchFields rejects every key that is not a patchable column, case variants included
+func checkPatchFields(fields map[string]interface{}, columns map[string]bool) error {
+	for key := range fields {
+		if !columns[key] {
+			return errors.New("field " + key + " cannot be patched")
+		}
 	}
 
-	return result.RowsAffected(), nil
+	return nil
 }
 
 func (c *productRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error) {
@@ -246,11 +721,18 @@ func (c *productRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRe
 		query string
 	)
 
+	delete(req.Fields, "version")
+
 	if len(req.Fields) == 0 {
 		err = errors.New("no updates provided")
 		return
 	}
 
+	err = checkPatchFields(req.Fields, productPatchColumns)
+	if err != nil {
+		return
+	}
+
 	req.Fields["id"] = req.Id
 
 	for key := range req.Fields {
@@ -261,31 +743,312 @@ func (c *productRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRe
 		ind++
 	}
 
+	req.Fields["version"] = req.Version
+
 	query = `
 		UPDATE
 			"product"
-	` + set + ` , updated_at = now()
+	` + set + ` , version = version + 1, updated_at = now()
 		WHERE
-			id = :id
+			id = :id AND version = :version
 	`
 
 	query, args := helper.ReplaceQueryParams(query, req.Fields)
 
-	result, err := c.db.Exec(ctx, query, args...)
-	if err != nil {
-		return
-	}
-
-	return result.RowsAffected(), err
+	return execAudited(ctx, c.db, models.AuditActionPatch, query, args...)
 }
 
 func (c *productRepo) Delete(ctx context.Context, req *product_service.ProductPK) error {
 	query := `DELETE FROM "product" WHERE id = $1`
 
-	_, err := c.db.Exec(ctx, query, req.Id)
+	_, err := execAudited(ctx, c.db, models.AuditActionDelete, query, req.Id)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// getBrandFacets counts the products matching the filter per brand, products without a brand are counted under an empty id
+func (c *productRepo) getBrandFacets(ctx context.Context, from, filter string, params map[string]interface{}) (resp []*product_service.BrandFacet, err error) {
+	query := `
+		SELECT
+			f.brand_id,
+			b.name,
+			f.count
+		FROM (
+			SELECT brand_id, COUNT(*) AS count
+			FROM ` + from + filter + `
+			GROUP BY brand_id
+		) f
+		LEFT JOIN "brand" b ON b.id = f.brand_id
+		ORDER BY f.count DESC, b.name
+	`
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			brand_id sql.NullString
+			name     sql.NullString
+			count    sql.NullInt64
+		)
+
+		err := rows.Scan(&brand_id, &name, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, &product_service.BrandFacet{
+			BrandId: brand_id.String,
+			Name:    name.String,
+			Count:   count.Int64,
+		})
+	}
+
+	return resp, rows.Err()
+}
+
+// GetIDByBarcode finds the product by its barcode, barcodes of merged products resolve to the product they were merged into
+func (c *productRepo) GetIDByBarcode(ctx context.Context, barcode string) (resp *product_service.ProductPK, err error) {
+	query := `
+		SELECT id FROM (
+			SELECT id, 0 AS rank FROM "product" WHERE barcode = $1
+			UNION ALL
+			SELECT to_id, 1 FROM "product_redirect" WHERE barcode = $1
+		) found
+		ORDER BY rank
+		LIMIT 1
+	`
+
+	var id sql.NullString
+
+	err = c.db.QueryRow(ctx, query, barcode).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ProductPK{Id: id.String}, nil
+}
+
+// GetIDByGtin finds the product with the GTIN, or else the product whose barcode is encoded in it,
+// leading zeros are not significant
+func (c *productRepo) GetIDByGtin(ctx context.Context, gtin string) (resp *product_service.ProductPK, err error) {
+	query := `
+		SELECT id FROM (
+			SELECT id, 0 AS rank FROM "product" WHERE gtin = LPAD($1, 14, '0')
+			UNION ALL
+			SELECT id, 1 FROM "product" WHERE LTRIM(barcode, '0') = LTRIM($1, '0')
+		) found
+		ORDER BY rank
+		LIMIT 1
+	`
+
+	var id sql.NullString
+
+	err = c.db.QueryRow(ctx, query, gtin).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ProductPK{Id: id.String}, nil
+}
+
+// RepriceByMarkup sets price = cost_price * (1 + markup / 100) rounded to roundTo for every product of the category that has a cost,
+// a cost in another currency is converted to the price currency first and products without a rate are skipped
+func (c *productRepo) RepriceByMarkup(ctx context.Context, req *product_service.RepriceByMarkupRequest) (resp *product_service.RepriceByMarkupResponse, err error) {
+	resp = &product_service.RepriceByMarkupResponse{}
+
+	query := `
+		UPDATE "product" p
+		SET
+			price = ROUND((old.cost * (1 + $2::double precision / 100) / $3::double precision)::numeric) * $3::double precision,
+			version = p.version + 1,
+			updated_at = now()
+		FROM (
+			SELECT
+				id,
+				price,
+				cost_price * currency_rate(cost_currency, NOW()::timestamp) / currency_rate(currency, NOW()::timestamp) AS cost
+			FROM "product"
+			WHERE category_id = $1 AND cost_price > 0
+			FOR UPDATE
+		) old
+		WHERE p.id = old.id AND old.cost IS NOT NULL
+		RETURNING p.id, old.price, p.price
+	`
+
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return resp, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = setAuditContext(ctx, tx, models.AuditActionReprice)
+	if err != nil {
+		return resp, err
+	}
+
+	rows, err := tx.Query(ctx, query, req.GetCategoryId(), req.GetMarkup(), req.GetRoundTo())
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id        sql.NullString
+			old_price sql.NullFloat64
+			new_price sql.NullFloat64
+		)
+
+		err := rows.Scan(&id, &old_price, &new_price)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Products = append(resp.Products, &product_service.RepricedProduct{
+			ProductId: id.String,
+			OldPrice:  float32(old_price.Float64),
+			NewPrice:  float32(new_price.Float64),
+		})
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return resp, err
+	}
+
+	resp.UpdatedCount = int64(len(resp.Products))
+
+	return resp, tx.Commit(ctx)
+}
+
+// productVatRate selects the VAT rate of the product, falling back to the default rate of its category,
+// the category and tax rate are read as they were at asOf when one is given
+func productVatRate(asOf string) string {
+	categories, taxRates := `"category"`, `"tax_rate"`
+	if len(asOf) > 0 {
+		categories, taxRates = tableAsOf("category", asOf), tableAsOf("tax_rate", asOf)
+	}
+
+	return `COALESCE(
+				(SELECT t.rate FROM ` + taxRates + ` t WHERE t.id = "product".tax_rate_id),
+				(SELECT t.rate FROM ` + categories + ` c JOIN ` + taxRates + ` t ON t.id = c.tax_rate_id WHERE c.id = "product".category_id),
+				0
+			)`
+}
+
+// productTagIds selects the ids of the tags of the product
+const productTagIds = `ARRAY(SELECT pt.tag_id::text FROM "product_tag" pt WHERE pt.product_id = "product".id ORDER BY pt.created_at)`
+
+// productMarkingRequired selects whether items of the product must be scanned with a marking code, set on the product,
+// its category or any category above it, the categories are read as they were at asOf when one is given
+func productMarkingRequired(asOf string) string {
+	categories := `"category"`
+	if len(asOf) > 0 {
+		categories = tableAsOf("category", asOf)
+	}
+
+	return `(
+				COALESCE("product".requires_marking, FALSE) OR
+				COALESCE((
+					SELECT bool_or(c.requires_marking) FROM ` + categories + ` c
+					WHERE c.id IN ` + categoryAncestors(categories, `"product".category_id`) + `
+				), FALSE)
+			)`
+}
+
+// productGrossWeightKg selects the gross weight of the product in kilograms whatever unit it is kept in
+const productGrossWeightKg = `(gross_weight * CASE weight_unit WHEN 'g' THEN 0.001 ELSE 1 END)`
+
+// productAsOf rebuilds the "product" relation from the history snapshots that were valid at the given moment
+func productAsOf(asOf string) string {
+	return tableAsOf("product", asOf) + ` AS "product"`
+}
+
+// tableAsOf rebuilds the rows of a system versioned table from its history snapshots that were valid at the given moment
+func tableAsOf(table, asOf string) string {
+	return `(
+			SELECT (jsonb_populate_record(NULL::"` + table + `", data)).*
+			FROM "` + table + `_history"
+			WHERE valid_from <= ` + asOf + ` AND (valid_to IS NULL OR valid_to > ` + asOf + `)
+		)`
+}
+
+// productInsert creates a product row from a CreateProduct, its arguments come from productInsertArgs
+const productInsert = `
+		INSERT INTO "product" (
+			id,
+			photo,
+			name,
+			category_id,
+			barcode,
+			price,
+			cost_price,
+			tax_rate_id,
+			mxik_code,
+			package_code,
+			price_excludes_vat,
+			requires_marking,
+			currency,
+			cost_currency,
+			unit_id,
+			brand_id,
+			manufacturer_id,
+			net_weight,
+			gross_weight,
+			weight_unit,
+			length,
+			width,
+			height,
+			dimension_unit,
+			temperature_class,
+			gtin,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+			$18, $19, $20, $21, $22, $23, $24, $25, $26, NOW(), NOW())
+	`
+
+// productInsertArgs lists the arguments of productInsert, a product without a barcode gets a generated one
+func productInsertArgs(id string, req *product_service.CreateProduct) []interface{} {
+	barcode := req.GetBarcode()
+	if len(barcode) == 0 {
+		barcode = helper.GenerateBarcode(9)
+	}
+
+	return []interface{}{
+		id,
+		req.Photo,
+		req.Name,
+		req.CategoryId,
+		barcode,
+		req.Price,
+		req.CostPrice,
+		helper.NewNullString(req.TaxRateId),
+		helper.NewNullString(req.MxikCode),
+		helper.NewNullString(req.PackageCode),
+		req.PriceExcludesVat,
+		req.RequiresMarking,
+		req.Currency,
+		req.CostCurrency,
+		req.UnitId,
+		helper.NewNullString(req.BrandId),
+		helper.NewNullString(req.ManufacturerId),
+		req.NetWeight,
+		req.GrossWeight,
+		req.WeightUnit,
+		req.Length,
+		req.Width,
+		req.Height,
+		req.DimensionUnit,
+		helper.NewNullString(req.TemperatureClass),
+		helper.NewNullString(req.Gtin),
+	}
+}
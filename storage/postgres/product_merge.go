@@ -0,0 +1,242 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/models"
+	"product_service/pkg/helper"
+)
+
+// duplicateCandidates scores every pair of products sharing a barcode or a similar name,
+// a shared barcode is conclusive, otherwise the name similarity weighs most and equal category and price add to it
+const duplicateCandidates = `
+	SELECT
+		a.id,
+		a.name,
+		b.id,
+		b.name,
+		a.barcode = b.barcode AS barcode_match,
+		similarity(a.name, b.name) AS name_similarity,
+		COALESCE(a.category_id = b.category_id, FALSE) AS same_category,
+		ABS(a.price - b.price) <= 0.01 * GREATEST(a.price, b.price) AS same_price
+	FROM "product" a
+	JOIN "product" b ON a.id < b.id AND (a.barcode = b.barcode OR a.name % b.name)
+`
+
+// mergeReferences moves what belongs to the merged product $2 onto the kept product $1,
+// rows the kept product already has an equivalent of stay behind and go away with the merged product
+var mergeReferences = []string{
+	`UPDATE "price_list_item" i SET product_id = $1
+		WHERE i.product_id = $2 AND NOT EXISTS (
+			SELECT 1 FROM "price_list_item" k
+			WHERE k.product_id = $1 AND k.price_list_id = i.price_list_id AND k.min_quantity = i.min_quantity
+		)`,
+	`UPDATE "price_override" o SET product_id = $1
+		WHERE o.product_id = $2 AND NOT EXISTS (
+			SELECT 1 FROM "price_override" k
+			WHERE k.product_id = $1 AND (k.filial_id = o.filial_id OR k.magazin_id = o.magazin_id)
+		)`,
+	`UPDATE "product_price_history" SET product_id = $1 WHERE product_id = $2`,
+	`UPDATE "scheduled_price_change" s SET product_id = $1
+		WHERE s.product_id = $2 AND (s.status <> 'pending' OR NOT EXISTS (
+			SELECT 1 FROM "scheduled_price_change" k
+			WHERE k.product_id = $1 AND k.status = 'pending' AND k.effective_from = s.effective_from
+		))`,
+	`UPDATE "product_provider_cost" c SET product_id = $1
+		WHERE c.product_id = $2 AND NOT EXISTS (
+			SELECT 1 FROM "product_provider_cost" k WHERE k.product_id = $1 AND k.provider_id = c.provider_id
+		)`,
+	`UPDATE "sale_restriction" SET product_id = $1 WHERE product_id = $2`,
+	`UPDATE "product_packaging" SET product_id = $1 WHERE product_id = $2`,
+	`INSERT INTO "product_tag" (product_id, tag_id, created_at)
+		SELECT $1, tag_id, created_at FROM "product_tag" WHERE product_id = $2
+		ON CONFLICT DO NOTHING`,
+	`UPDATE "product_relation" r SET product_id = $1
+		WHERE r.product_id = $2 AND r.related_id <> $1 AND NOT EXISTS (
+			SELECT 1 FROM "product_relation" k WHERE k.product_id = $1 AND k.related_id = r.related_id AND k.type = r.type
+		)`,
+	`UPDATE "product_relation" r SET related_id = $1
+		WHERE r.related_id = $2 AND r.product_id <> $1 AND NOT EXISTS (
+			SELECT 1 FROM "product_relation" k WHERE k.related_id = $1 AND k.product_id = r.product_id AND k.type = r.type
+		)`,
+	`DELETE FROM "product_relation" WHERE related_id = $2`,
+	`UPDATE "bundle_component" c SET quantity = c.quantity + m.quantity
+		FROM "bundle_component" m
+		WHERE c.component_id = $1 AND m.component_id = $2 AND m.bundle_id = c.bundle_id`,
+	`UPDATE "bundle_component" c SET component_id = $1
+		WHERE c.component_id = $2 AND c.bundle_id <> $1 AND NOT EXISTS (
+			SELECT 1 FROM "bundle_component" k WHERE k.bundle_id = c.bundle_id AND k.component_id = $1
+		)`,
+	`DELETE FROM "bundle_component" WHERE component_id = $2`,
+	`UPDATE "promotion" SET product_ids = ARRAY(SELECT DISTINCT UNNEST(array_replace(product_ids, $2, $1)))
+		WHERE $2 = ANY(product_ids)`,
+	`INSERT INTO "stock" (product_id, magazin_id, created_at, updated_at)
+		SELECT $1, magazin_id, NOW(), NOW() FROM "stock" WHERE product_id = $2
+		ON CONFLICT (product_id, magazin_id) DO UPDATE SET updated_at = NOW()`,
+	`INSERT INTO "stock_movement" (product_id, magazin_id, type, quantity, document_id, comment, created_at)
+		SELECT moved.product_id, l.magazin_id, 'adjustment', moved.sign * l.quantity, $2::TEXT, 'product merge', NOW()
+		FROM "stock_level" l
+		CROSS JOIN (VALUES ($1::UUID, 1), ($2::UUID, -1)) moved (product_id, sign)
+		WHERE l.product_id = $2 AND l.quantity <> 0`,
+	`UPDATE "goods_receipt_line" SET product_id = $1 WHERE product_id = $2`,
+	`UPDATE "purchase_order_line" l SET quantity = l.quantity + m.quantity
+		FROM "purchase_order_line" m
+		WHERE l.product_id = $1 AND m.product_id = $2 AND m.order_id = l.order_id`,
+	`DELETE FROM "purchase_order_line" l
+		WHERE l.product_id = $2 AND EXISTS (
+			SELECT 1 FROM "purchase_order_line" k WHERE k.order_id = l.order_id AND k.product_id = $1
+		)`,
+	`UPDATE "purchase_order_line" SET product_id = $1 WHERE product_id = $2`,
+	`UPDATE "product_redirect" SET to_id = $1 WHERE to_id = $2`,
+	`INSERT INTO "product_redirect" (from_id, barcode, to_id, created_at)
+		SELECT id, barcode, $1, NOW() FROM "product" WHERE id = $2`,
+	`DELETE FROM "product" WHERE id = $2`,
+}
+
+// FindDuplicates returns the candidate pairs scoring at least minScore, best first
+func (c *productRepo) FindDuplicates(ctx context.Context, req *product_service.FindDuplicatesRequest) (resp *product_service.FindDuplicatesResponse, err error) {
+	resp = &product_service.FindDuplicatesResponse{}
+
+	var (
+		limit  = ""
+		params = map[string]interface{}{"min_score": req.GetMinScore()}
+		filter = " WHERE TRUE "
+	)
+
+	if len(req.GetProductId()) > 0 {
+		filter += " AND (a.id = :product_id OR b.id = :product_id) "
+		params["product_id"] = req.ProductId
+	}
+	if len(req.GetCategoryId()) > 0 {
+		filter += " AND (a.category_id = :category_id OR b.category_id = :category_id) "
+		params["category_id"] = req.CategoryId
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+
+	query := `
+		SELECT * FROM (
+			SELECT
+				pairs.*,
+				CASE WHEN barcode_match THEN 1
+					ELSE 0.6 * name_similarity + 0.2 * same_category::INT + 0.2 * same_price::INT
+				END AS score
+			FROM (` + duplicateCandidates + filter + `) pairs
+		) scored
+		WHERE score >= :min_score
+		ORDER BY score DESC` + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			product_id      sql.NullString
+			product_name    sql.NullString
+			duplicate_id    sql.NullString
+			duplicate_name  sql.NullString
+			barcode_match   sql.NullBool
+			name_similarity sql.NullFloat64
+			same_category   sql.NullBool
+			same_price      sql.NullBool
+			score           sql.NullFloat64
+		)
+
+		err := rows.Scan(
+			&product_id,
+			&product_name,
+			&duplicate_id,
+			&duplicate_name,
+			&barcode_match,
+			&name_similarity,
+			&same_category,
+			&same_price,
+			&score,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Candidates = append(resp.Candidates, &product_service.DuplicateCandidate{
+			ProductId:      product_id.String,
+			ProductName:    product_name.String,
+			DuplicateId:    duplicate_id.String,
+			DuplicateName:  duplicate_name.String,
+			Score:          float32(score.Float64),
+			BarcodeMatch:   barcode_match.Bool,
+			NameSimilarity: float32(name_similarity.Float64),
+			SameCategory:   same_category.Bool,
+			SamePrice:      same_price.Bool,
+		})
+	}
+
+	return resp, rows.Err()
+}
+
+// Merge folds the merged products into the kept one and deletes them, leaving redirects for their ids and barcodes
+func (c *productRepo) Merge(ctx context.Context, keepId string, mergeIds []string) error {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = setAuditContext(ctx, tx, models.AuditActionMerge)
+	if err != nil {
+		return err
+	}
+
+	// products being merged must not change under us
+	_, err = tx.Exec(ctx, `SELECT id FROM "product" WHERE id = $1 OR id = ANY($2) FOR UPDATE`, keepId, mergeIds)
+	if err != nil {
+		return err
+	}
+
+	// nor may their stock, the merged stock moves onto the kept product
+	_, err = tx.Exec(ctx, `
+		SELECT 1 FROM "stock" WHERE product_id = $1 OR product_id = ANY($2)
+		ORDER BY product_id, magazin_id
+		FOR UPDATE
+	`, keepId, mergeIds)
+	if err != nil {
+		return err
+	}
+
+	for _, mergeId := range mergeIds {
+		for _, query := range mergeReferences {
+			_, err = tx.Exec(ctx, query, keepId, mergeId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE "product" SET version = version + 1, updated_at = NOW() WHERE id = $1`, keepId)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetRedirect returns the product the merged product id now resolves to
+func (c *productRepo) GetRedirect(ctx context.Context, id string) (resp *product_service.ProductPK, err error) {
+	query := `SELECT to_id FROM "product_redirect" WHERE from_id = $1`
+
+	var to_id sql.NullString
+
+	err = c.db.QueryRow(ctx, query, id).Scan(&to_id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ProductPK{Id: to_id.String}, nil
+}
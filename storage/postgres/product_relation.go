@@ -0,0 +1,256 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const productRelationColumns = `
+			id,
+			product_id,
+			related_id,
+			type,
+			position,
+			created_at,
+			updated_at
+`
+
+type productRelationRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewProductRelationRepo(db *pgxpool.Pool) *productRelationRepo {
+	return &productRelationRepo{
+		db: db,
+	}
+}
+
+// Set creates the relation, an existing relation of the same type between the same products only gets the new position,
+// a relation given by id is rewritten in place
+func (c *productRelationRepo) Set(ctx context.Context, req *product_service.SetProductRelationRequest) (resp *product_service.ProductRelationPK, err error) {
+	var (
+		id    = req.GetId()
+		query string
+	)
+
+	if len(id) > 0 {
+		query = `
+			UPDATE "product_relation"
+			SET
+				product_id = $2,
+				related_id = $3,
+				type = $4,
+				position = $5,
+				updated_at = NOW()
+			WHERE id = $1
+			RETURNING id
+		`
+	} else {
+		id = uuid.New().String()
+		query = `
+			INSERT INTO "product_relation" (
+				id,
+				product_id,
+				related_id,
+				type,
+				position,
+				created_at,
+				updated_at
+			) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+			ON CONFLICT (product_id, related_id, type) DO UPDATE SET
+				position = EXCLUDED.position,
+				updated_at = NOW()
+			RETURNING id
+		`
+	}
+
+	err = c.db.QueryRow(
+		ctx,
+		query,
+		id,
+		req.ProductId,
+		req.RelatedId,
+		req.Type,
+		req.Position,
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ProductRelationPK{Id: id}, nil
+}
+
+func (c *productRelationRepo) GetByID(ctx context.Context, req *product_service.ProductRelationPK) (resp *product_service.ProductRelation, err error) {
+	query := `
+		SELECT ` + productRelationColumns + `
+		FROM "product_relation"
+		WHERE id = $1;
+	`
+
+	return scanProductRelation(c.db.QueryRow(ctx, query, req.Id))
+}
+
+func (c *productRelationRepo) GetList(ctx context.Context, req *product_service.GetProductRelationsRequest) (resp *product_service.GetProductRelationsResponse, err error) {
+	resp = &product_service.GetProductRelationsResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY type, position, created_at "
+	)
+
+	query = `
+	   SELECT
+	   		COUNT(*) OVER(), ` + productRelationColumns + `
+		FROM "product_relation"
+	`
+	if len(req.GetProductId()) > 0 {
+		filter += " AND product_id = :product_id "
+		params["product_id"] = req.ProductId
+	}
+	if len(req.GetType()) > 0 {
+		filter += " AND type = :type "
+		params["type"] = req.Type
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		relation, err := scanProductRelation(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Relations = append(resp.Relations, relation)
+	}
+
+	return resp, rows.Err()
+}
+
+// GetRelatedProducts returns the active products related to the product in the order they should be shown
+func (c *productRelationRepo) GetRelatedProducts(ctx context.Context, productId string) (resp []*product_service.RelatedProduct, err error) {
+	query := `
+		SELECT
+			pr.id,
+			pr.type,
+			pr.position,
+			p.id,
+			p.name,
+			p.barcode,
+			p.photo,
+			p.price
+		FROM "product_relation" pr
+		JOIN "product" p ON p.id = pr.related_id
+		WHERE pr.product_id = $1 AND p.active
+		ORDER BY pr.type, pr.position, pr.created_at
+	`
+
+	rows, err := c.db.Query(ctx, query, productId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			relation_id sql.NullString
+			relation    sql.NullString
+			position    sql.NullInt32
+			product_id  sql.NullString
+			name        sql.NullString
+			barcode     sql.NullString
+			photo       sql.NullString
+			price       sql.NullFloat64
+		)
+
+		err := rows.Scan(&relation_id, &relation, &position, &product_id, &name, &barcode, &photo, &price)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, &product_service.RelatedProduct{
+			RelationId: relation_id.String,
+			Type:       relation.String,
+			Position:   position.Int32,
+			ProductId:  product_id.String,
+			Name:       name.String,
+			Barcode:    barcode.String,
+			Photo:      photo.String,
+			Price:      float32(price.Float64),
+		})
+	}
+
+	return resp, rows.Err()
+}
+
+func (c *productRelationRepo) Delete(ctx context.Context, req *product_service.ProductRelationPK) error {
+	query := `DELETE FROM "product_relation" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanProductRelation(row pgx.Row, prefix ...interface{}) (*product_service.ProductRelation, error) {
+	var (
+		id         sql.NullString
+		product_id sql.NullString
+		related_id sql.NullString
+		relation   sql.NullString
+		position   sql.NullInt32
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	dest := append(prefix,
+		&id,
+		&product_id,
+		&related_id,
+		&relation,
+		&position,
+		&created_at,
+		&updated_at,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ProductRelation{
+		Id:        id.String,
+		ProductId: product_id.String,
+		RelatedId: related_id.String,
+		Type:      relation.String,
+		Position:  position.Int32,
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}, nil
+}
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const productTemplateColumns = `
+			id,
+			name,
+			category_id,
+			defaults,
+			created_at,
+			updated_at
+`
+
+type productTemplateRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewProductTemplateRepo(db *pgxpool.Pool) *productTemplateRepo {
+	return &productTemplateRepo{
+		db: db,
+	}
+}
+
+func (c *productTemplateRepo) Create(ctx context.Context, req *product_service.CreateProductTemplate) (resp *product_service.ProductTemplatePK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "product_template" (
+			id,
+			name,
+			category_id,
+			defaults,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, NOW(), NOW())
+	`
+
+	defaults, err := marshalProductDefaults(req.Defaults)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.Name,
+		helper.NewNullString(req.CategoryId),
+		defaults,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ProductTemplatePK{Id: id}, nil
+}
+
+func (c *productTemplateRepo) GetByID(ctx context.Context, req *product_service.ProductTemplatePK) (resp *product_service.ProductTemplate, err error) {
+	query := `
+		SELECT ` + productTemplateColumns + `
+		FROM "product_template"
+		WHERE id = $1;
+	`
+
+	return scanProductTemplate(c.db.QueryRow(ctx, query, req.Id))
+}
+
+func (c *productTemplateRepo) GetList(ctx context.Context, req *product_service.GetListProductTemplateRequest) (resp *product_service.GetListProductTemplateResponse, err error) {
+	resp = &product_service.GetListProductTemplateResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY name "
+	)
+
+	query = `
+	   SELECT
+	   		COUNT(*) OVER(), ` + productTemplateColumns + `
+		FROM "product_template"
+	`
+	if len(req.GetSearch()) > 0 {
+		filter += " AND name ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
+	}
+	if len(req.GetCategoryId()) > 0 {
+		filter += " AND category_id = :category_id "
+		params["category_id"] = req.CategoryId
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		template, err := scanProductTemplate(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Templates = append(resp.Templates, template)
+	}
+
+	return resp, rows.Err()
+}
+
+// GetByCategory returns the template new products of the category are filled from
+func (c *productTemplateRepo) GetByCategory(ctx context.Context, categoryId string) (resp *product_service.ProductTemplate, err error) {
+	query := `
+		SELECT ` + productTemplateColumns + `
+		FROM "product_template"
+		WHERE category_id = $1;
+	`
+
+	return scanProductTemplate(c.db.QueryRow(ctx, query, categoryId))
+}
+
+func (c *productTemplateRepo) Update(ctx context.Context, req *product_service.UpdateProductTemplate) (resp int64, err error) {
+	query := `
+		UPDATE
+			"product_template"
+		SET
+			name = $2,
+			category_id = $3,
+			defaults = $4,
+			updated_at = now()
+		WHERE id = $1
+	`
+
+	defaults, err := marshalProductDefaults(req.Defaults)
+	if err != nil {
+		return
+	}
+
+	result, err := c.db.Exec(ctx, query, req.GetId(), req.GetName(), helper.NewNullString(req.GetCategoryId()), defaults)
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *productTemplateRepo) Delete(ctx context.Context, req *product_service.ProductTemplatePK) error {
+	query := `DELETE FROM "product_template" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func marshalProductDefaults(defaults *product_service.CreateProduct) ([]byte, error) {
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(defaults)
+}
+
+func scanProductTemplate(row pgx.Row, prefix ...interface{}) (*product_service.ProductTemplate, error) {
+	var (
+		id          sql.NullString
+		name        sql.NullString
+		category_id sql.NullString
+		defaults    []byte
+		created_at  sql.NullString
+		updated_at  sql.NullString
+	)
+
+	dest := append(prefix,
+		&id,
+		&name,
+		&category_id,
+		&defaults,
+		&created_at,
+		&updated_at,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &product_service.ProductTemplate{
+		Id:         id.String,
+		Name:       name.String,
+		CategoryId: category_id.String,
+		Defaults:   &product_service.CreateProduct{},
+		CreatedAt:  created_at.String,
+		UpdatedAt:  updated_at.String,
+	}
+
+	// defaults saved by newer versions may carry fields this one does not know
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(defaults, resp.Defaults)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
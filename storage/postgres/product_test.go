@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestCheckPatchFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		valid  bool
+	}{
+		{name: "patchable columns", fields: map[string]interface{}{"name": "milk", "cost_price": 10.0, "barcode": "4600439931256"}, valid: true},
+		{name: "mixed case cost price", fields: map[string]interface{}{"Cost_Price": 10.0}},
+		{name: "upper case cost price", fields: map[string]interface{}{"COST_PRICE": 10.0}},
+		{name: "mixed case barcode", fields: map[string]interface{}{"Barcode": "4600439931256"}},
+		{name: "mixed case gtin", fields: map[string]interface{}{"GTIN": "04600439931256"}},
+		{name: "mixed case mxik code", fields: map[string]interface{}{"Mxik_Code": "10101001001001001"}},
+		{name: "version", fields: map[string]interface{}{"Version": 1.0}},
+		{name: "id", fields: map[string]interface{}{"id": "00000000-0000-0000-0000-000000000001"}},
+		{name: "bundle flag", fields: map[string]interface{}{"is_bundle": true}},
+		{name: "active flag", fields: map[string]interface{}{"active": false}},
+		{name: "sql in the key", fields: map[string]interface{}{"name = 'x', price": 1.0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPatchFields(tt.fields, productPatchColumns)
+			if (err == nil) != tt.valid {
+				t.Errorf("checkPatchFields() error = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const promotionColumns = `
+			id,
+			name,
+			product_ids,
+			category_ids,
+			filial_ids,
+			min_quantity,
+			starts_at,
+			ends_at,
+			daily_from,
+			daily_to,
+			type,
+			percent,
+			amount,
+			buy_quantity,
+			get_quantity,
+			bundle_price,
+			priority,
+			active,
+			created_at,
+			updated_at
+`
+
+type promotionRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPromotionRepo(db *pgxpool.Pool) *promotionRepo {
+	return &promotionRepo{
+		db: db,
+	}
+}
+
+func (c *promotionRepo) Create(ctx context.Context, req *product_service.CreatePromotion) (resp *product_service.PromotionPK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "promotion" (
+			id,
+			name,
+			product_ids,
+			category_ids,
+			filial_ids,
+			min_quantity,
+			starts_at,
+			ends_at,
+			daily_from,
+			daily_to,
+			type,
+			percent,
+			amount,
+			buy_quantity,
+			get_quantity,
+			bundle_price,
+			priority,
+			active,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, NOW(), NOW())
+	`
+
+	condition := req.GetCondition()
+	action := req.GetAction()
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.Name,
+		condition.GetProductIds(),
+		condition.GetCategoryIds(),
+		condition.GetFilialIds(),
+		condition.GetMinQuantity(),
+		helper.NewNullString(condition.GetStartsAt()),
+		helper.NewNullString(condition.GetEndsAt()),
+		helper.NewNullString(condition.GetDailyFrom()),
+		helper.NewNullString(condition.GetDailyTo()),
+		action.GetType(),
+		action.GetPercent(),
+		action.GetAmount(),
+		action.GetBuyQuantity(),
+		action.GetGetQuantity(),
+		action.GetBundlePrice(),
+		req.Priority,
+		req.Active,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.PromotionPK{Id: id}, nil
+}
+
+func (c *promotionRepo) GetByID(ctx context.Context, req *product_service.PromotionPK) (*product_service.Promotion, error) {
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM "promotion"
+		WHERE id = $1;
+	`
+
+	return scanPromotion(c.db.QueryRow(ctx, query, req.Id))
+}
+
+func (c *promotionRepo) GetList(ctx context.Context, req *product_service.GetListPromotionRequest) (resp *product_service.GetListPromotionResponse, err error) {
+	resp = &product_service.GetListPromotionResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY priority DESC, created_at DESC "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(), ` + promotionColumns + `
+		FROM "promotion"
+	`
+	if len(req.GetSearch()) > 0 {
+		filter += " AND name ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
+	}
+	if req.GetOnlyActive() {
+		filter += " AND active AND (starts_at IS NULL OR starts_at <= NOW()) AND (ends_at IS NULL OR ends_at > NOW()) "
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		promotion, err := scanPromotion(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Promotions = append(resp.Promotions, promotion)
+	}
+
+	return resp, rows.Err()
+}
+
+// GetActive returns the promotions that are switched on and whose validity window contains the moment
+func (c *promotionRepo) GetActive(ctx context.Context, at time.Time) (resp []*product_service.Promotion, err error) {
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM "promotion"
+		WHERE active
+			AND (starts_at IS NULL OR starts_at <= $1)
+			AND (ends_at IS NULL OR ends_at > $1)
+		ORDER BY priority DESC
+	`
+
+	rows, err := c.db.Query(ctx, query, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		promotion, err := scanPromotion(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, promotion)
+	}
+
+	return resp, rows.Err()
+}
+
+func (c *promotionRepo) Update(ctx context.Context, req *product_service.UpdatePromotion) (resp int64, err error) {
+	query := `
+		UPDATE
+			"promotion"
+		SET
+			name = $2,
+			product_ids = $3,
+			category_ids = $4,
+			filial_ids = $5,
+			min_quantity = $6,
+			starts_at = $7,
+			ends_at = $8,
+			daily_from = $9,
+			daily_to = $10,
+			type = $11,
+			percent = $12,
+			amount = $13,
+			buy_quantity = $14,
+			get_quantity = $15,
+			bundle_price = $16,
+			priority = $17,
+			active = $18,
+			updated_at = now()
+		WHERE id = $1
+	`
+
+	condition := req.GetCondition()
+	action := req.GetAction()
+
+	result, err := c.db.Exec(
+		ctx,
+		query,
+		req.GetId(),
+		req.GetName(),
+		condition.GetProductIds(),
+		condition.GetCategoryIds(),
+		condition.GetFilialIds(),
+		condition.GetMinQuantity(),
+		helper.NewNullString(condition.GetStartsAt()),
+		helper.NewNullString(condition.GetEndsAt()),
+		helper.NewNullString(condition.GetDailyFrom()),
+		helper.NewNullString(condition.GetDailyTo()),
+		action.GetType(),
+		action.GetPercent(),
+		action.GetAmount(),
+		action.GetBuyQuantity(),
+		action.GetGetQuantity(),
+		action.GetBundlePrice(),
+		req.GetPriority(),
+		req.GetActive(),
+	)
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *promotionRepo) Delete(ctx context.Context, req *product_service.PromotionPK) error {
+	query := `DELETE FROM "promotion" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// scanPromotion reads promotionColumns, prefix receives the leading columns such as COUNT(*) OVER()
+func scanPromotion(row pgx.Row, prefix ...interface{}) (*product_service.Promotion, error) {
+	var (
+		id           sql.NullString
+		name         sql.NullString
+		product_ids  []string
+		category_ids []string
+		filial_ids   []string
+		min_quantity sql.NullFloat64
+		starts_at    sql.NullString
+		ends_at      sql.NullString
+		daily_from   sql.NullString
+		daily_to     sql.NullString
+		typ          sql.NullString
+		percent      sql.NullFloat64
+		amount       sql.NullFloat64
+		buy_quantity sql.NullFloat64
+		get_quantity sql.NullFloat64
+		bundle_price sql.NullFloat64
+		priority     sql.NullInt32
+		active       sql.NullBool
+		created_at   sql.NullString
+		updated_at   sql.NullString
+	)
+
+	dest := append(prefix,
+		&id,
+		&name,
+		&product_ids,
+		&category_ids,
+		&filial_ids,
+		&min_quantity,
+		&starts_at,
+		&ends_at,
+		&daily_from,
+		&daily_to,
+		&typ,
+		&percent,
+		&amount,
+		&buy_quantity,
+		&get_quantity,
+		&bundle_price,
+		&priority,
+		&active,
+		&created_at,
+		&updated_at,
+	)
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	return &product_service.Promotion{
+		Id:   id.String,
+		Name: name.String,
+		Condition: &product_service.PromotionCondition{
+			ProductIds:  product_ids,
+			CategoryIds: category_ids,
+			FilialIds:   filial_ids,
+			MinQuantity: min_quantity.Float64,
+			StartsAt:    starts_at.String,
+			EndsAt:      ends_at.String,
+			DailyFrom:   daily_from.String,
+			DailyTo:     daily_to.String,
+		},
+		Action: &product_service.PromotionAction{
+			Type:        typ.String,
+			Percent:     float32(percent.Float64),
+			Amount:      float32(amount.Float64),
+			BuyQuantity: buy_quantity.Float64,
+			GetQuantity: get_quantity.Float64,
+			BundlePrice: float32(bundle_price.Float64),
+		},
+		Priority:  priority.Int32,
+		Active:    active.Bool,
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}, nil
+}
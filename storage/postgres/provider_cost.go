@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type providerCostRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewProviderCostRepo(db *pgxpool.Pool) *providerCostRepo {
+	return &providerCostRepo{
+		db: db,
+	}
+}
+
+// Set creates the provider cost of the product or replaces the existing one
+func (c *providerCostRepo) Set(ctx context.Context, req *product_service.SetProviderCostRequest) (resp *product_service.ProviderCost, err error) {
+	query := `
+		INSERT INTO "product_provider_cost" (
+			id,
+			product_id,
+			provider_id,
+			cost_price,
+			currency,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		ON CONFLICT (product_id, provider_id) DO UPDATE SET
+			cost_price = EXCLUDED.cost_price,
+			currency = EXCLUDED.currency,
+			updated_at = NOW()
+		RETURNING id, product_id, provider_id, cost_price, currency, created_at, updated_at
+	`
+	var (
+		id          sql.NullString
+		product_id  sql.NullString
+		provider_id sql.NullString
+		cost_price  sql.NullFloat64
+		currency    sql.NullString
+		created_at  sql.NullString
+		updated_at  sql.NullString
+	)
+
+	err = c.db.QueryRow(
+		ctx,
+		query,
+		uuid.New().String(),
+		req.ProductId,
+		req.ProviderId,
+		req.CostPrice,
+		req.Currency,
+	).Scan(
+		&id,
+		&product_id,
+		&provider_id,
+		&cost_price,
+		&currency,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.ProviderCost{
+		Id:         id.String,
+		ProductId:  product_id.String,
+		ProviderId: provider_id.String,
+		CostPrice:  float32(cost_price.Float64),
+		Currency:   currency.String,
+		CreatedAt:  created_at.String,
+		UpdatedAt:  updated_at.String,
+	}, nil
+}
+
+func (c *providerCostRepo) GetList(ctx context.Context, req *product_service.GetProviderCostsRequest) (resp *product_service.GetProviderCostsResponse, err error) {
+	resp = &product_service.GetProviderCostsResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY cost_price "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   product_id,
+			   provider_id,
+			   cost_price,
+			   currency,
+			   created_at,
+			   updated_at
+		FROM "product_provider_cost"
+	`
+	if len(req.GetProductId()) > 0 {
+		filter += " AND product_id = :product_id "
+		params["product_id"] = req.ProductId
+	}
+	if len(req.GetProviderId()) > 0 {
+		filter += " AND provider_id = :provider_id "
+		params["provider_id"] = req.ProviderId
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id          sql.NullString
+			product_id  sql.NullString
+			provider_id sql.NullString
+			cost_price  sql.NullFloat64
+			currency    sql.NullString
+			created_at  sql.NullString
+			updated_at  sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&product_id,
+			&provider_id,
+			&cost_price,
+			&currency,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Costs = append(resp.Costs, &product_service.ProviderCost{
+			Id:         id.String,
+			ProductId:  product_id.String,
+			ProviderId: provider_id.String,
+			CostPrice:  float32(cost_price.Float64),
+			Currency:   currency.String,
+			CreatedAt:  created_at.String,
+			UpdatedAt:  updated_at.String,
+		})
+	}
+
+	return
+}
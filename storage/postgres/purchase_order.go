@@ -0,0 +1,508 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"product_service/config"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const purchaseOrderColumns = `
+			o.id,
+			o.provider_id,
+			o.magazin_id,
+			TO_CHAR(o.expected_date, 'YYYY-MM-DD'),
+			o.status,
+			o.currency,
+			o.comment,
+			o.staff_id,
+			o.sent_at,
+			o.cancelled_at,
+			(SELECT COALESCE(SUM(l.quantity * l.cost_price), 0) FROM "purchase_order_line" l WHERE l.order_id = o.id),
+			o.created_at,
+			o.updated_at
+`
+
+// purchaseOrderReceived is the quantity of the order line product received by the posted receipts of the order
+const purchaseOrderReceived = `
+	COALESCE((
+		SELECT SUM(gl.quantity)
+		FROM "goods_receipt_line" gl
+		JOIN "goods_receipt" g ON g.id = gl.receipt_id
+		WHERE g.purchase_order_id = l.order_id AND g.status = '` + config.DocumentStatusPosted + `' AND gl.product_id = l.product_id
+	), 0)
+`
+
+type purchaseOrderRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPurchaseOrderRepo(db *pgxpool.Pool) *purchaseOrderRepo {
+	return &purchaseOrderRepo{
+		db: db,
+	}
+}
+
+func (c *purchaseOrderRepo) Create(ctx context.Context, req *product_service.CreatePurchaseOrder) (resp *product_service.PurchaseOrderPK, err error) {
+	id := uuid.New().String()
+
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "purchase_order" (
+			id,
+			provider_id,
+			magazin_id,
+			expected_date,
+			status,
+			currency,
+			comment,
+			staff_id,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4::DATE, $5, $6, $7, $8, NOW(), NOW())
+	`,
+		id,
+		req.ProviderId,
+		req.MagazinId,
+		helper.NewNullString(req.ExpectedDate),
+		config.DocumentStatusDraft,
+		req.Currency,
+		helper.NewNullString(req.Comment),
+		helper.NewNullString(req.StaffId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for position, line := range req.Lines {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "purchase_order_line" (
+				id,
+				order_id,
+				product_id,
+				quantity,
+				cost_price,
+				position
+			) VALUES ($1, $2, $3, $4, $5, $6)
+		`,
+			uuid.New().String(),
+			id,
+			line.GetProductId(),
+			line.GetQuantity(),
+			line.GetCostPrice(),
+			position,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &product_service.PurchaseOrderPK{Id: id}, tx.Commit(ctx)
+}
+
+func (c *purchaseOrderRepo) GetByID(ctx context.Context, req *product_service.PurchaseOrderPK) (resp *product_service.PurchaseOrder, err error) {
+	query := `
+		SELECT ` + purchaseOrderColumns + `
+		FROM "purchase_order" o
+		WHERE o.id = $1
+	`
+
+	resp, err = scanPurchaseOrder(c.db.QueryRow(ctx, query, req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.Query(ctx, `
+		SELECT
+			l.id,
+			l.product_id,
+			p.name,
+			l.quantity,
+			l.cost_price,
+			`+purchaseOrderReceived+`
+		FROM "purchase_order_line" l
+		JOIN "product" p ON p.id = l.product_id
+		WHERE l.order_id = $1
+		ORDER BY l.position
+	`, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id                sql.NullString
+			product_id        sql.NullString
+			product_name      sql.NullString
+			quantity          sql.NullFloat64
+			cost_price        sql.NullFloat64
+			received_quantity sql.NullFloat64
+		)
+
+		err := rows.Scan(&id, &product_id, &product_name, &quantity, &cost_price, &received_quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Lines = append(resp.Lines, &product_service.PurchaseOrderLine{
+			Id:               id.String,
+			ProductId:        product_id.String,
+			ProductName:      product_name.String,
+			Quantity:         quantity.Float64,
+			CostPrice:        float32(cost_price.Float64),
+			ReceivedQuantity: received_quantity.Float64,
+		})
+	}
+
+	return resp, rows.Err()
+}
+
+func (c *purchaseOrderRepo) GetList(ctx context.Context, req *product_service.GetListPurchaseOrderRequest) (resp *product_service.GetListPurchaseOrderResponse, err error) {
+	resp = &product_service.GetListPurchaseOrderResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY o.created_at DESC "
+	)
+
+	query = `
+	   SELECT
+	   		COUNT(*) OVER(), ` + purchaseOrderColumns + `
+		FROM "purchase_order" o
+	`
+	if len(req.GetProviderId()) > 0 {
+		filter += " AND o.provider_id = :provider_id "
+		params["provider_id"] = req.ProviderId
+	}
+	if len(req.GetMagazinId()) > 0 {
+		filter += " AND o.magazin_id = :magazin_id "
+		params["magazin_id"] = req.MagazinId
+	}
+	if len(req.GetStatus()) > 0 {
+		filter += " AND o.status = :status "
+		params["status"] = req.Status
+	}
+	if len(req.GetFromDate()) > 0 {
+		filter += " AND o.created_at >= :from_date "
+		params["from_date"] = req.FromDate
+	}
+	if len(req.GetToDate()) > 0 {
+		filter += " AND o.created_at <= :to_date "
+		params["to_date"] = req.ToDate
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		order, err := scanPurchaseOrder(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Orders = append(resp.Orders, order)
+	}
+
+	return resp, rows.Err()
+}
+
+// Send marks a draft order as sent to the provider
+func (c *purchaseOrderRepo) Send(ctx context.Context, req *product_service.PurchaseOrderPK) (rowsAffected int64, err error) {
+	query := `
+		UPDATE "purchase_order"
+		SET
+			status = $2,
+			sent_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND status = $3
+	`
+
+	result, err := c.db.Exec(ctx, query, req.Id, config.PurchaseOrderStatusSent, config.DocumentStatusDraft)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// Cancel closes an order that is not fully received, goods already received stay in stock
+func (c *purchaseOrderRepo) Cancel(ctx context.Context, req *product_service.PurchaseOrderPK) (rowsAffected int64, err error) {
+	query := `
+		UPDATE "purchase_order"
+		SET
+			status = $2,
+			cancelled_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND status IN ($3, $4, $5)
+	`
+
+	result, err := c.db.Exec(ctx, query,
+		req.Id,
+		config.PurchaseOrderStatusCancelled,
+		config.DocumentStatusDraft,
+		config.PurchaseOrderStatusSent,
+		config.PurchaseOrderStatusPartiallyReceived,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// Receive posts a goods receipt of the order with the received lines and moves the order to partially received,
+// or to received once every ordered quantity has arrived. It returns an empty id when the order is not awaiting goods
+func (c *purchaseOrderRepo) Receive(ctx context.Context, req *product_service.ReceivePurchaseOrderRequest) (receiptId string, err error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		provider_id sql.NullString
+		magazin_id  sql.NullString
+		currency    sql.NullString
+	)
+
+	err = tx.QueryRow(ctx, `
+		SELECT provider_id, magazin_id, currency
+		FROM "purchase_order"
+		WHERE id = $1 AND status IN ($2, $3)
+		FOR UPDATE
+	`,
+		req.GetId(),
+		config.PurchaseOrderStatusSent,
+		config.PurchaseOrderStatusPartiallyReceived,
+	).Scan(&provider_id, &magazin_id, &currency)
+	if err == pgx.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// only ordered goods are received against the order
+	productIds := make([]string, 0, len(req.GetLines()))
+	for _, line := range req.GetLines() {
+		productIds = append(productIds, line.GetProductId())
+	}
+
+	var unordered sql.NullString
+	err = tx.QueryRow(ctx, `
+		SELECT p.id
+		FROM UNNEST($2::TEXT[]) p (id)
+		WHERE NOT EXISTS (
+			SELECT 1 FROM "purchase_order_line" l WHERE l.order_id = $1 AND l.product_id::TEXT = p.id
+		)
+		LIMIT 1
+	`, req.GetId(), productIds).Scan(&unordered)
+	if err == nil {
+		return "", fmt.Errorf(config.ErrProductNotOrdered, unordered.String)
+	}
+	if err != pgx.ErrNoRows {
+		return "", err
+	}
+
+	receiptId = uuid.New().String()
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "goods_receipt" (
+			id,
+			provider_id,
+			magazin_id,
+			invoice_number,
+			status,
+			currency,
+			comment,
+			staff_id,
+			purchase_order_id,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+	`,
+		receiptId,
+		provider_id.String,
+		magazin_id.String,
+		helper.NewNullString(req.GetInvoiceNumber()),
+		config.DocumentStatusDraft,
+		currency.String,
+		helper.NewNullString(req.GetComment()),
+		helper.NewNullString(req.GetStaffId()),
+		req.GetId(),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	err = insertGoodsReceiptLines(ctx, tx, receiptId, req.GetLines())
+	if err != nil {
+		return "", err
+	}
+
+	_, err = postGoodsReceipt(ctx, tx, receiptId, req.GetStaffId())
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE "purchase_order" o
+		SET
+			status = CASE WHEN EXISTS (
+				SELECT 1 FROM "purchase_order_line" l
+				WHERE l.order_id = o.id AND l.quantity > `+purchaseOrderReceived+`
+			) THEN $2 ELSE $3 END,
+			updated_at = NOW()
+		WHERE o.id = $1
+	`,
+		req.GetId(),
+		config.PurchaseOrderStatusPartiallyReceived,
+		config.PurchaseOrderStatusReceived,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return receiptId, tx.Commit(ctx)
+}
+
+// Reconcile compares the ordered quantities with everything the posted receipts of the order brought in,
+// products received without being ordered come last with nothing ordered
+func (c *purchaseOrderRepo) Reconcile(ctx context.Context, req *product_service.PurchaseOrderPK) (resp []*product_service.ReconciliationLine, err error) {
+	query := `
+		WITH received AS (
+			SELECT gl.product_id, SUM(gl.quantity) AS quantity
+			FROM "goods_receipt_line" gl
+			JOIN "goods_receipt" g ON g.id = gl.receipt_id
+			WHERE g.purchase_order_id = $1 AND g.status = $2
+			GROUP BY gl.product_id
+		), ordered AS (
+			SELECT product_id, quantity, position
+			FROM "purchase_order_line"
+			WHERE order_id = $1
+		)
+		SELECT
+			COALESCE(o.product_id, r.product_id),
+			p.name,
+			COALESCE(o.quantity, 0),
+			COALESCE(r.quantity, 0)
+		FROM ordered o
+		FULL JOIN received r ON r.product_id = o.product_id
+		LEFT JOIN "product" p ON p.id = COALESCE(o.product_id, r.product_id)
+		ORDER BY o.position NULLS LAST, p.name
+	`
+
+	rows, err := c.db.Query(ctx, query, req.Id, config.DocumentStatusPosted)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			product_id   sql.NullString
+			product_name sql.NullString
+			ordered      sql.NullFloat64
+			received     sql.NullFloat64
+		)
+
+		err := rows.Scan(&product_id, &product_name, &ordered, &received)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, &product_service.ReconciliationLine{
+			ProductId:   product_id.String,
+			ProductName: product_name.String,
+			Ordered:     ordered.Float64,
+			Received:    received.Float64,
+		})
+	}
+
+	return resp, rows.Err()
+}
+
+func scanPurchaseOrder(row pgx.Row, prefix ...interface{}) (*product_service.PurchaseOrder, error) {
+	var (
+		id            sql.NullString
+		provider_id   sql.NullString
+		magazin_id    sql.NullString
+		expected_date sql.NullString
+		status        sql.NullString
+		currency      sql.NullString
+		comment       sql.NullString
+		staff_id      sql.NullString
+		sent_at       sql.NullString
+		cancelled_at  sql.NullString
+		total         sql.NullFloat64
+		created_at    sql.NullString
+		updated_at    sql.NullString
+	)
+
+	dest := append(prefix,
+		&id,
+		&provider_id,
+		&magazin_id,
+		&expected_date,
+		&status,
+		&currency,
+		&comment,
+		&staff_id,
+		&sent_at,
+		&cancelled_at,
+		&total,
+		&created_at,
+		&updated_at,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.PurchaseOrder{
+		Id:           id.String,
+		ProviderId:   provider_id.String,
+		MagazinId:    magazin_id.String,
+		ExpectedDate: expected_date.String,
+		Status:       status.String,
+		Currency:     currency.String,
+		Comment:      comment.String,
+		StaffId:      staff_id.String,
+		SentAt:       sent_at.String,
+		CancelledAt:  cancelled_at.String,
+		Total:        float32(total.Float64),
+		CreatedAt:    created_at.String,
+		UpdatedAt:    updated_at.String,
+	}, nil
+}
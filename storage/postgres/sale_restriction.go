@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const saleRestrictionColumns = `
+			id,
+			product_id,
+			category_id,
+			filial_id,
+			min_age,
+			sale_from,
+			sale_to,
+			max_quantity,
+			created_at,
+			updated_at
+`
+
+type saleRestrictionRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewSaleRestrictionRepo(db *pgxpool.Pool) *saleRestrictionRepo {
+	return &saleRestrictionRepo{
+		db: db,
+	}
+}
+
+// Set creates the restriction, or replaces the rule of the existing one when the id is given
+func (c *saleRestrictionRepo) Set(ctx context.Context, req *product_service.SetSaleRestrictionRequest) (resp *product_service.SaleRestrictionPK, err error) {
+	id := req.GetId()
+	if len(id) == 0 {
+		id = uuid.New().String()
+	}
+
+	query := `
+		INSERT INTO "sale_restriction" (
+			id,
+			product_id,
+			category_id,
+			filial_id,
+			min_age,
+			sale_from,
+			sale_to,
+			max_quantity,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		ON CONFLICT (id) DO UPDATE SET
+			product_id = EXCLUDED.product_id,
+			category_id = EXCLUDED.category_id,
+			filial_id = EXCLUDED.filial_id,
+			min_age = EXCLUDED.min_age,
+			sale_from = EXCLUDED.sale_from,
+			sale_to = EXCLUDED.sale_to,
+			max_quantity = EXCLUDED.max_quantity,
+			updated_at = NOW()
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		helper.NewNullString(req.ProductId),
+		helper.NewNullString(req.CategoryId),
+		helper.NewNullString(req.FilialId),
+		req.MinAge,
+		helper.NewNullString(req.SaleFrom),
+		helper.NewNullString(req.SaleTo),
+		req.MaxQuantity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.SaleRestrictionPK{Id: id}, nil
+}
+
+func (c *saleRestrictionRepo) GetByID(ctx context.Context, req *product_service.SaleRestrictionPK) (resp *product_service.SaleRestriction, err error) {
+	query := `
+		SELECT ` + saleRestrictionColumns + `
+		FROM "sale_restriction"
+		WHERE id = $1;
+	`
+
+	return scanSaleRestriction(c.db.QueryRow(ctx, query, req.Id))
+}
+
+func (c *saleRestrictionRepo) GetList(ctx context.Context, req *product_service.GetSaleRestrictionsRequest) (resp *product_service.GetSaleRestrictionsResponse, err error) {
+	resp = &product_service.GetSaleRestrictionsResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY created_at DESC "
+	)
+
+	query = `
+	   SELECT
+	   		COUNT(*) OVER(), ` + saleRestrictionColumns + `
+		FROM "sale_restriction"
+	`
+	if len(req.GetProductId()) > 0 {
+		filter += " AND product_id = :product_id "
+		params["product_id"] = req.ProductId
+	}
+	if len(req.GetCategoryId()) > 0 {
+		filter += " AND category_id = :category_id "
+		params["category_id"] = req.CategoryId
+	}
+	if len(req.GetFilialId()) > 0 {
+		filter += " AND filial_id = :filial_id "
+		params["filial_id"] = req.FilialId
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		restriction, err := scanSaleRestriction(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Restrictions = append(resp.Restrictions, restriction)
+	}
+
+	return resp, rows.Err()
+}
+
+// GetActive returns the restrictions set on the product, its category or any category above it,
+// for every filial or the given one
+func (c *saleRestrictionRepo) GetActive(ctx context.Context, productId, categoryId, filialId string) (resp []*product_service.SaleRestriction, err error) {
+	query := `
+		SELECT ` + saleRestrictionColumns + `
+		FROM "sale_restriction"
+		WHERE (product_id = $1 OR category_id IN ` + categoryAncestors(`"category"`, "$2") + `)
+			AND (filial_id IS NULL OR filial_id = $3)
+		ORDER BY created_at
+	`
+
+	rows, err := c.db.Query(ctx, query, productId, helper.NewNullString(categoryId), helper.NewNullString(filialId))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		restriction, err := scanSaleRestriction(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, restriction)
+	}
+
+	return resp, rows.Err()
+}
+
+func (c *saleRestrictionRepo) Delete(ctx context.Context, req *product_service.SaleRestrictionPK) error {
+	query := `DELETE FROM "sale_restriction" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanSaleRestriction(row pgx.Row, prefix ...interface{}) (*product_service.SaleRestriction, error) {
+	var (
+		id           sql.NullString
+		product_id   sql.NullString
+		category_id  sql.NullString
+		filial_id    sql.NullString
+		min_age      sql.NullInt32
+		sale_from    sql.NullString
+		sale_to      sql.NullString
+		max_quantity sql.NullFloat64
+		created_at   sql.NullString
+		updated_at   sql.NullString
+	)
+
+	dest := append(prefix,
+		&id,
+		&product_id,
+		&category_id,
+		&filial_id,
+		&min_age,
+		&sale_from,
+		&sale_to,
+		&max_quantity,
+		&created_at,
+		&updated_at,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.SaleRestriction{
+		Id:          id.String,
+		ProductId:   product_id.String,
+		CategoryId:  category_id.String,
+		FilialId:    filial_id.String,
+		MinAge:      min_age.Int32,
+		SaleFrom:    sale_from.String,
+		SaleTo:      sale_to.String,
+		MaxQuantity: max_quantity.Float64,
+		CreatedAt:   created_at.String,
+		UpdatedAt:   updated_at.String,
+	}, nil
+}
@@ -0,0 +1,475 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/models"
+	"product_service/pkg/helper"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const stockColumns = `
+			s.product_id,
+			s.magazin_id,
+			s.quantity,
+			p.name,
+			s.updated_at
+`
+
+const stockMovementColumns = `
+			m.id,
+			m.product_id,
+			m.magazin_id,
+			m.type,
+			m.quantity,
+			m.document_id,
+			m.staff_id,
+			m.comment,
+			m.created_at,
+			p.name
+`
+
+type stockRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewStockRepo(db *pgxpool.Pool) *stockRepo {
+	return &stockRepo{
+		db: db,
+	}
+}
+
+// Get returns the stock of the product in the magazin, a product never stocked there has none
+func (c *stockRepo) Get(ctx context.Context, req *product_service.GetStockRequest) (resp *product_service.Stock, err error) {
+	query := `
+		SELECT
+			p.id,
+			$2::UUID,
+			COALESCE(s.quantity, 0),
+			p.name,
+			s.updated_at
+		FROM "product" p
+		LEFT JOIN "stock_level" s ON s.product_id = p.id AND s.magazin_id = $2
+		WHERE p.id = $1
+	`
+
+	return scanStock(c.db.QueryRow(ctx, query, req.GetProductId(), req.GetMagazinId()))
+}
+
+func (c *stockRepo) GetList(ctx context.Context, req *product_service.ListStockRequest) (resp *product_service.ListStockResponse, err error) {
+	resp = &product_service.ListStockResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY p.name "
+	)
+
+	query = `
+	   SELECT
+	   		COUNT(*) OVER(), ` + stockColumns + `
+		FROM "stock_level" s
+		JOIN "product" p ON p.id = s.product_id
+	`
+	if len(req.GetMagazinId()) > 0 {
+		filter += " AND s.magazin_id = :magazin_id "
+		params["magazin_id"] = req.MagazinId
+	}
+	if len(req.GetProductId()) > 0 {
+		filter += " AND s.product_id = :product_id "
+		params["product_id"] = req.ProductId
+	}
+	if len(req.GetSearch()) > 0 {
+		filter += " AND p.name ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
+	}
+	if req.GetInStock() {
+		filter += " AND s.quantity > 0 "
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		stock, err := scanStock(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Stocks = append(resp.Stocks, stock)
+	}
+
+	return resp, rows.Err()
+}
+
+// Adjust records the movements in one transaction and returns the resulting stock. The stock rows are locked
+// in product order, so concurrent adjustments of the same products wait for each other instead of both selling the last unit
+func (c *stockRepo) Adjust(ctx context.Context, movements []*product_service.StockMovement, allowNegative bool) (resp []*product_service.Stock, err error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	resp, err = recordMovements(ctx, tx, movements, allowNegative)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, tx.Commit(ctx)
+}
+
+func (c *stockRepo) GetMovements(ctx context.Context, req *product_service.ListMovementsRequest) (resp *product_service.ListMovementsResponse, err error) {
+	resp = &product_service.ListMovementsResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY m.id DESC "
+	)
+
+	query = `
+	   SELECT
+	   		COUNT(*) OVER(), ` + stockMovementColumns + `
+		FROM "stock_movement" m
+		LEFT JOIN "product" p ON p.id = m.product_id
+	`
+	if len(req.GetProductId()) > 0 {
+		filter += " AND m.product_id = :product_id "
+		params["product_id"] = req.ProductId
+	}
+	if len(req.GetMagazinId()) > 0 {
+		filter += " AND m.magazin_id = :magazin_id "
+		params["magazin_id"] = req.MagazinId
+	}
+	if len(req.GetType()) > 0 {
+		filter += " AND m.type = :type "
+		params["type"] = req.Type
+	}
+	if len(req.GetDocumentId()) > 0 {
+		filter += " AND m.document_id = :document_id "
+		params["document_id"] = req.DocumentId
+	}
+	if len(req.GetFromDate()) > 0 {
+		filter += " AND m.created_at >= :from_date "
+		params["from_date"] = req.FromDate
+	}
+	if len(req.GetToDate()) > 0 {
+		filter += " AND m.created_at <= :to_date "
+		params["to_date"] = req.ToDate
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		movement, err := scanStockMovement(rows, &resp.Count)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Movements = append(resp.Movements, movement)
+	}
+
+	return resp, rows.Err()
+}
+
+// TakeSnapshots folds the movements recorded since the last snapshot into the snapshot of every stock row that has any.
+// The rows are locked in the same order adjustments lock them, so no movement can commit behind a snapshot
+func (c *stockRepo) TakeSnapshots(ctx context.Context) (taken int64, err error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		SELECT 1 FROM "stock"
+		WHERE EXISTS (
+			SELECT 1 FROM "stock_movement" m
+			LEFT JOIN "stock_snapshot" sn ON sn.product_id = m.product_id AND sn.magazin_id = m.magazin_id
+			WHERE m.product_id = "stock".product_id AND m.magazin_id = "stock".magazin_id
+				AND m.id > COALESCE(sn.last_movement_id, 0)
+		)
+		ORDER BY product_id, magazin_id
+		FOR UPDATE
+	`)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO "stock_snapshot" (product_id, magazin_id, quantity, last_movement_id, taken_at)
+		SELECT
+			l.product_id,
+			l.magazin_id,
+			l.quantity,
+			last.id,
+			NOW()
+		FROM "stock_level" l
+		JOIN LATERAL (
+			SELECT MAX(m.id) AS id FROM "stock_movement" m
+			WHERE m.product_id = l.product_id AND m.magazin_id = l.magazin_id
+		) last ON last.id IS NOT NULL
+		LEFT JOIN "stock_snapshot" sn ON sn.product_id = l.product_id AND sn.magazin_id = l.magazin_id
+		WHERE last.id > COALESCE(sn.last_movement_id, 0)
+		ON CONFLICT (product_id, magazin_id) DO UPDATE SET
+			quantity = EXCLUDED.quantity,
+			last_movement_id = EXCLUDED.last_movement_id,
+			taken_at = EXCLUDED.taken_at
+	`)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), tx.Commit(ctx)
+}
+
+func (c *stockRepo) SetSetting(ctx context.Context, req *product_service.SetStockSettingRequest) (resp *product_service.StockSetting, err error) {
+	query := `
+		INSERT INTO "stock_setting" (
+			magazin_id,
+			allow_negative,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, NOW(), NOW())
+		ON CONFLICT (magazin_id) DO UPDATE SET
+			allow_negative = EXCLUDED.allow_negative,
+			updated_at = NOW()
+	`
+
+	_, err = c.db.Exec(ctx, query, req.GetMagazinId(), req.GetAllowNegative())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetSetting(ctx, &product_service.StockSettingPK{MagazinId: req.GetMagazinId()})
+}
+
+func (c *stockRepo) GetSetting(ctx context.Context, req *product_service.StockSettingPK) (resp *product_service.StockSetting, err error) {
+	query := `
+		SELECT
+			magazin_id,
+			allow_negative,
+			updated_at
+		FROM "stock_setting"
+		WHERE magazin_id = $1
+	`
+
+	var (
+		magazin_id     sql.NullString
+		allow_negative sql.NullBool
+		updated_at     sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.GetMagazinId()).Scan(&magazin_id, &allow_negative, &updated_at)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.StockSetting{
+		MagazinId:     magazin_id.String,
+		AllowNegative: allow_negative.Bool,
+		UpdatedAt:     updated_at.String,
+	}, nil
+}
+
+// recordMovements locks the stock rows of the movements inside the caller's transaction, checks the balances
+// and appends the movements to the ledger. The movements must be sorted by product and magazin and hold every pair once
+func recordMovements(ctx context.Context, tx pgx.Tx, movements []*product_service.StockMovement, allowNegative bool) (resp []*product_service.Stock, err error) {
+	for _, movement := range movements {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "stock" (product_id, magazin_id, created_at, updated_at)
+			VALUES ($1, $2, NOW(), NOW())
+			ON CONFLICT (product_id, magazin_id) DO NOTHING
+		`, movement.GetProductId(), movement.GetMagazinId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, movement := range movements {
+		_, err = tx.Exec(ctx, `
+			SELECT 1 FROM "stock" WHERE product_id = $1 AND magazin_id = $2 FOR UPDATE
+		`, movement.GetProductId(), movement.GetMagazinId())
+		if err != nil {
+			return nil, err
+		}
+
+		var quantity float64
+
+		err = tx.QueryRow(ctx, `
+			SELECT quantity FROM "stock_level" WHERE product_id = $1 AND magazin_id = $2
+		`, movement.GetProductId(), movement.GetMagazinId()).Scan(&quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowNegative && movement.GetQuantity() < 0 && quantity+movement.GetQuantity() < 0 {
+			return nil, &models.InsufficientStockError{
+				ProductId: movement.GetProductId(),
+				Available: quantity,
+				Requested: -movement.GetQuantity(),
+			}
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "stock_movement" (
+				product_id,
+				magazin_id,
+				type,
+				quantity,
+				document_id,
+				staff_id,
+				comment,
+				created_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		`,
+			movement.GetProductId(),
+			movement.GetMagazinId(),
+			movement.GetType(),
+			movement.GetQuantity(),
+			helper.NewNullString(movement.GetDocumentId()),
+			helper.NewNullString(movement.GetStaffId()),
+			helper.NewNullString(movement.GetComment()),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE "stock" SET updated_at = NOW() WHERE product_id = $1 AND magazin_id = $2
+		`, movement.GetProductId(), movement.GetMagazinId())
+		if err != nil {
+			return nil, err
+		}
+
+		stock, err := scanStock(tx.QueryRow(ctx, `
+			SELECT `+stockColumns+`
+			FROM "stock_level" s
+			JOIN "product" p ON p.id = s.product_id
+			WHERE s.product_id = $1 AND s.magazin_id = $2
+		`, movement.GetProductId(), movement.GetMagazinId()))
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, stock)
+	}
+
+	return resp, nil
+}
+
+func scanStock(row pgx.Row, prefix ...interface{}) (*product_service.Stock, error) {
+	var (
+		product_id   sql.NullString
+		magazin_id   sql.NullString
+		quantity     sql.NullFloat64
+		product_name sql.NullString
+		updated_at   sql.NullString
+	)
+
+	dest := append(prefix,
+		&product_id,
+		&magazin_id,
+		&quantity,
+		&product_name,
+		&updated_at,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.Stock{
+		ProductId:   product_id.String,
+		MagazinId:   magazin_id.String,
+		Quantity:    quantity.Float64,
+		ProductName: product_name.String,
+		UpdatedAt:   updated_at.String,
+	}, nil
+}
+
+func scanStockMovement(row pgx.Row, prefix ...interface{}) (*product_service.StockMovement, error) {
+	var (
+		id           sql.NullInt64
+		product_id   sql.NullString
+		magazin_id   sql.NullString
+		movement     sql.NullString
+		quantity     sql.NullFloat64
+		document_id  sql.NullString
+		staff_id     sql.NullString
+		comment      sql.NullString
+		created_at   sql.NullString
+		product_name sql.NullString
+	)
+
+	dest := append(prefix,
+		&id,
+		&product_id,
+		&magazin_id,
+		&movement,
+		&quantity,
+		&document_id,
+		&staff_id,
+		&comment,
+		&created_at,
+		&product_name,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.StockMovement{
+		Id:          id.Int64,
+		ProductId:   product_id.String,
+		MagazinId:   magazin_id.String,
+		Type:        movement.String,
+		Quantity:    quantity.Float64,
+		DocumentId:  document_id.String,
+		StaffId:     staff_id.String,
+		Comment:     comment.String,
+		CreatedAt:   created_at.String,
+		ProductName: product_name.String,
+	}, nil
+}
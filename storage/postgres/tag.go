@@ -0,0 +1,222 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type tagRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTagRepo(db *pgxpool.Pool) *tagRepo {
+	return &tagRepo{
+		db: db,
+	}
+}
+
+func (c *tagRepo) Create(ctx context.Context, req *product_service.CreateTag) (resp *product_service.TagPK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "tag" (
+			id,
+			name,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, NOW(), NOW())
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.Name,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.TagPK{Id: id}, nil
+}
+
+func (c *tagRepo) GetByID(ctx context.Context, req *product_service.TagPK) (resp *product_service.Tag, err error) {
+	query := `
+		SELECT
+			id,
+			name,
+			(SELECT COUNT(*) FROM "product_tag" pt WHERE pt.tag_id = "tag".id),
+			created_at,
+			updated_at
+		FROM "tag"
+		WHERE id = $1;
+	`
+	var (
+		id            sql.NullString
+		name          sql.NullString
+		product_count sql.NullInt64
+		created_at    sql.NullString
+		updated_at    sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&name,
+		&product_count,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return resp, err
+	}
+
+	resp = &product_service.Tag{
+		Id:           id.String,
+		Name:         name.String,
+		ProductCount: product_count.Int64,
+		CreatedAt:    created_at.String,
+		UpdatedAt:    updated_at.String,
+	}
+
+	return
+}
+
+func (c *tagRepo) GetList(ctx context.Context, req *product_service.GetListTagRequest) (resp *product_service.GetListTagResponse, err error) {
+	resp = &product_service.GetListTagResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY name "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   name,
+			   (SELECT COUNT(*) FROM "product_tag" pt WHERE pt.tag_id = "tag".id),
+			   created_at,
+			   updated_at
+		FROM "tag"
+	`
+	if len(req.GetSearch()) > 0 {
+		filter += " AND name ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id            sql.NullString
+			name          sql.NullString
+			product_count sql.NullInt64
+			created_at    sql.NullString
+			updated_at    sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&name,
+			&product_count,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Tags = append(resp.Tags, &product_service.Tag{
+			Id:           id.String,
+			Name:         name.String,
+			ProductCount: product_count.Int64,
+			CreatedAt:    created_at.String,
+			UpdatedAt:    updated_at.String,
+		})
+	}
+
+	return
+}
+
+func (c *tagRepo) Update(ctx context.Context, req *product_service.UpdateTag) (resp int64, err error) {
+	query := `
+		UPDATE
+			"tag"
+		SET
+			name = $2,
+			updated_at = now()
+		WHERE id = $1
+	`
+
+	result, err := c.db.Exec(ctx, query, req.GetId(), req.GetName())
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *tagRepo) Delete(ctx context.Context, req *product_service.TagPK) error {
+	query := `DELETE FROM "tag" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TagProducts attaches every tag to every product, pairs that already exist are left alone
+func (c *tagRepo) TagProducts(ctx context.Context, req *product_service.TagProductsRequest) (resp int64, err error) {
+	query := `
+		INSERT INTO "product_tag" (product_id, tag_id, created_at)
+		SELECT p, t, NOW()
+		FROM UNNEST($1::uuid[]) p, UNNEST($2::uuid[]) t
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := c.db.Exec(ctx, query, req.GetProductIds(), req.GetTagIds())
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// UntagProducts detaches every tag from every product
+func (c *tagRepo) UntagProducts(ctx context.Context, req *product_service.TagProductsRequest) (resp int64, err error) {
+	query := `DELETE FROM "product_tag" WHERE product_id = ANY($1) AND tag_id = ANY($2)`
+
+	result, err := c.db.Exec(ctx, query, req.GetProductIds(), req.GetTagIds())
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type taxRateRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTaxRateRepo(db *pgxpool.Pool) *taxRateRepo {
+	return &taxRateRepo{
+		db: db,
+	}
+}
+
+func (c *taxRateRepo) Create(ctx context.Context, req *product_service.CreateTaxRate) (resp *product_service.TaxRatePK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "tax_rate" (
+			id,
+			name,
+			rate,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, NOW(), NOW())
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.Name,
+		req.Rate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.TaxRatePK{Id: id}, nil
+}
+
+func (c *taxRateRepo) GetByID(ctx context.Context, req *product_service.TaxRatePK) (resp *product_service.TaxRate, err error) {
+	query := `
+		SELECT
+			id,
+			name,
+			rate,
+			created_at,
+			updated_at
+		FROM "tax_rate"
+		WHERE id = $1;
+	`
+	var (
+		id         sql.NullString
+		name       sql.NullString
+		rate       sql.NullFloat64
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&name,
+		&rate,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return resp, err
+	}
+
+	resp = &product_service.TaxRate{
+		Id:        id.String,
+		Name:      name.String,
+		Rate:      float32(rate.Float64),
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}
+
+	return
+}
+
+func (c *taxRateRepo) GetList(ctx context.Context, req *product_service.GetListTaxRateRequest) (resp *product_service.GetListTaxRateResponse, err error) {
+	resp = &product_service.GetListTaxRateResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY rate "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   name,
+			   rate,
+			   created_at,
+			   updated_at
+		FROM "tax_rate"
+	`
+	if len(req.GetSearch()) > 0 {
+		filter += " AND name ILIKE '%' || :search || '%' "
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullString
+			name       sql.NullString
+			rate       sql.NullFloat64
+			created_at sql.NullString
+			updated_at sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&name,
+			&rate,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.TaxRates = append(resp.TaxRates, &product_service.TaxRate{
+			Id:        id.String,
+			Name:      name.String,
+			Rate:      float32(rate.Float64),
+			CreatedAt: created_at.String,
+			UpdatedAt: updated_at.String,
+		})
+	}
+
+	return
+}
+
+func (c *taxRateRepo) Update(ctx context.Context, req *product_service.UpdateTaxRate) (resp int64, err error) {
+	query := `
+		UPDATE
+			"tax_rate"
+		SET
+			name = $2,
+			rate = $3,
+			updated_at = now()
+		WHERE id = $1
+	`
+
+	result, err := c.db.Exec(ctx, query, req.GetId(), req.GetName(), req.GetRate())
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *taxRateRepo) Delete(ctx context.Context, req *product_service.TaxRatePK) error {
+	query := `DELETE FROM "tax_rate" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
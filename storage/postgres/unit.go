@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"product_service/genproto/product_service"
+	"product_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type unitRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewUnitRepo(db *pgxpool.Pool) *unitRepo {
+	return &unitRepo{
+		db: db,
+	}
+}
+
+func (c *unitRepo) Create(ctx context.Context, req *product_service.CreateUnit) (resp *product_service.UnitPK, err error) {
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "unit" (
+			id,
+			name,
+			code,
+			decimals,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, NOW(), NOW())
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.Name,
+		req.Code,
+		req.Decimals,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product_service.UnitPK{Id: id}, nil
+}
+
+func (c *unitRepo) GetByID(ctx context.Context, req *product_service.UnitPK) (resp *product_service.Unit, err error) {
+	query := `
+		SELECT
+			id,
+			name,
+			code,
+			decimals,
+			created_at,
+			updated_at
+		FROM "unit"
+		WHERE id = $1;
+	`
+	var (
+		id         sql.NullString
+		name       sql.NullString
+		code       sql.NullString
+		decimals   sql.NullInt32
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&name,
+		&code,
+		&decimals,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return resp, err
+	}
+
+	resp = &product_service.Unit{
+		Id:        id.String,
+		Name:      name.String,
+		Code:      code.String,
+		Decimals:  decimals.Int32,
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}
+
+	return
+}
+
+func (c *unitRepo) GetList(ctx context.Context, req *product_service.GetListUnitRequest) (resp *product_service.GetListUnitResponse, err error) {
+	resp = &product_service.GetListUnitResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY name "
+	)
+
+	query = `
+	   SELECT 
+	   		COUNT(*) OVER(),
+			   id,
+			   name,
+			   code,
+			   decimals,
+			   created_at,
+			   updated_at
+		FROM "unit"
+	`
+	if len(req.GetSearch()) > 0 {
+		filter += " AND (name ILIKE '%' || :search || '%' OR code ILIKE '%' || :search || '%') "
+		params["search"] = req.Search
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullString
+			name       sql.NullString
+			code       sql.NullString
+			decimals   sql.NullInt32
+			created_at sql.NullString
+			updated_at sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&name,
+			&code,
+			&decimals,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, err
+		}
+
+		resp.Units = append(resp.Units, &product_service.Unit{
+			Id:        id.String,
+			Name:      name.String,
+			Code:      code.String,
+			Decimals:  decimals.Int32,
+			CreatedAt: created_at.String,
+			UpdatedAt: updated_at.String,
+		})
+	}
+
+	return
+}
+
+func (c *unitRepo) Update(ctx context.Context, req *product_service.UpdateUnit) (resp int64, err error) {
+	query := `
+		UPDATE
+			"unit"
+		SET
+			name = $2,
+			code = $3,
+			decimals = $4,
+			updated_at = now()
+		WHERE id = $1
+	`
+
+	result, err := c.db.Exec(ctx, query, req.GetId(), req.GetName(), req.GetCode(), req.GetDecimals())
+	if err != nil {
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *unitRepo) Delete(ctx context.Context, req *product_service.UnitPK) error {
+	query := `DELETE FROM "unit" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
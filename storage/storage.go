@@ -4,21 +4,50 @@ import (
 	"context"
 	"product_service/genproto/product_service"
 	"product_service/models"
+	"time"
 )
 
 type StorageI interface {
 	CloseDB()
 	Category() CategoryRepoI
 	Product() ProductRepoI
+	Audit() AuditRepoI
+	Price() PriceRepoI
+	Promotion() PromotionRepoI
+	PriceList() PriceListRepoI
+	PriceOverride() PriceOverrideRepoI
+	ProviderCost() ProviderCostRepoI
+	TaxRate() TaxRateRepoI
+	SaleRestriction() SaleRestrictionRepoI
+	ExchangeRate() ExchangeRateRepoI
+	Unit() UnitRepoI
+	Packaging() PackagingRepoI
+	Brand() BrandRepoI
+	Manufacturer() ManufacturerRepoI
+	Tag() TagRepoI
+	Collection() CollectionRepoI
+	Bundle() BundleRepoI
+	ProductRelation() ProductRelationRepoI
+	ProductTemplate() ProductTemplateRepoI
+	Stock() StockRepoI
+	GoodsReceipt() GoodsReceiptRepoI
+	PurchaseOrder() PurchaseOrderRepoI
 }
 
 type ProductRepoI interface {
 	Create(context.Context, *product_service.CreateProduct) (*product_service.ProductPK, error)
+	Clone(ctx context.Context, sourceId string, req *product_service.CreateProduct) (*product_service.ProductPK, error)
 	GetByID(context.Context, *product_service.ProductPK) (*product_service.Product, error)
 	GetList(context.Context, *product_service.GetListProductRequest) (*product_service.GetListProductResponse, error)
 	Update(context.Context, *product_service.UpdateProduct) (int64, error)
 	UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error)
 	Delete(context.Context, *product_service.ProductPK) error
+	GetIDByBarcode(ctx context.Context, barcode string) (*product_service.ProductPK, error)
+	GetIDByGtin(ctx context.Context, gtin string) (*product_service.ProductPK, error)
+	RepriceByMarkup(context.Context, *product_service.RepriceByMarkupRequest) (*product_service.RepriceByMarkupResponse, error)
+	FindDuplicates(context.Context, *product_service.FindDuplicatesRequest) (*product_service.FindDuplicatesResponse, error)
+	Merge(ctx context.Context, keepId string, mergeIds []string) error
+	GetRedirect(ctx context.Context, id string) (*product_service.ProductPK, error)
 }
 
 type CategoryRepoI interface {
@@ -29,3 +58,171 @@ type CategoryRepoI interface {
 	UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error)
 	Delete(context.Context, *product_service.CategoryPK) error
 }
+
+type AuditRepoI interface {
+	GetList(ctx context.Context, entityType string, req *product_service.ListHistoryRequest) (*product_service.ListHistoryResponse, error)
+}
+
+type PriceRepoI interface {
+	SchedulePriceChange(context.Context, *product_service.SchedulePriceChangeRequest) (*product_service.ScheduledPriceChange, error)
+	GetHistory(context.Context, *product_service.GetPriceHistoryRequest) (*product_service.GetPriceHistoryResponse, error)
+	ApplyDueChanges(ctx context.Context) (int64, error)
+}
+
+type PromotionRepoI interface {
+	Create(context.Context, *product_service.CreatePromotion) (*product_service.PromotionPK, error)
+	GetByID(context.Context, *product_service.PromotionPK) (*product_service.Promotion, error)
+	GetList(context.Context, *product_service.GetListPromotionRequest) (*product_service.GetListPromotionResponse, error)
+	GetActive(ctx context.Context, at time.Time) ([]*product_service.Promotion, error)
+	Update(context.Context, *product_service.UpdatePromotion) (int64, error)
+	Delete(context.Context, *product_service.PromotionPK) error
+}
+
+type PriceListRepoI interface {
+	Create(context.Context, *product_service.CreatePriceList) (*product_service.PriceListPK, error)
+	GetByID(context.Context, *product_service.PriceListPK) (*product_service.PriceList, error)
+	GetList(context.Context, *product_service.GetListPriceListRequest) (*product_service.GetListPriceListResponse, error)
+	Update(context.Context, *product_service.UpdatePriceList) (int64, error)
+	Delete(context.Context, *product_service.PriceListPK) error
+	CreateItem(context.Context, *product_service.CreatePriceListItem) (*product_service.PriceListItemPK, error)
+	GetItemByID(context.Context, *product_service.PriceListItemPK) (*product_service.PriceListItem, error)
+	GetItems(context.Context, *product_service.GetListPriceListItemRequest) (*product_service.GetListPriceListItemResponse, error)
+	DeleteItem(context.Context, *product_service.PriceListItemPK) error
+	GetPrice(context.Context, *product_service.GetPriceRequest) (*product_service.GetPriceResponse, error)
+}
+
+type PriceOverrideRepoI interface {
+	Set(context.Context, *product_service.SetPriceOverrideRequest) (*product_service.PriceOverridePK, error)
+	GetByID(context.Context, *product_service.PriceOverridePK) (*product_service.PriceOverride, error)
+	GetList(context.Context, *product_service.GetPriceOverridesRequest) (*product_service.GetPriceOverridesResponse, error)
+	GetEffective(ctx context.Context, productIds []string, filialId, magazinId string) (map[string]*product_service.PriceOverride, error)
+	Delete(context.Context, *product_service.PriceOverridePK) error
+}
+
+type ProviderCostRepoI interface {
+	Set(context.Context, *product_service.SetProviderCostRequest) (*product_service.ProviderCost, error)
+	GetList(context.Context, *product_service.GetProviderCostsRequest) (*product_service.GetProviderCostsResponse, error)
+}
+
+type TaxRateRepoI interface {
+	Create(context.Context, *product_service.CreateTaxRate) (*product_service.TaxRatePK, error)
+	GetByID(context.Context, *product_service.TaxRatePK) (*product_service.TaxRate, error)
+	GetList(context.Context, *product_service.GetListTaxRateRequest) (*product_service.GetListTaxRateResponse, error)
+	Update(context.Context, *product_service.UpdateTaxRate) (int64, error)
+	Delete(context.Context, *product_service.TaxRatePK) error
+}
+
+type SaleRestrictionRepoI interface {
+	Set(context.Context, *product_service.SetSaleRestrictionRequest) (*product_service.SaleRestrictionPK, error)
+	GetByID(context.Context, *product_service.SaleRestrictionPK) (*product_service.SaleRestriction, error)
+	GetList(context.Context, *product_service.GetSaleRestrictionsRequest) (*product_service.GetSaleRestrictionsResponse, error)
+	GetActive(ctx context.Context, productId, categoryId, filialId string) ([]*product_service.SaleRestriction, error)
+	Delete(context.Context, *product_service.SaleRestrictionPK) error
+}
+
+type ExchangeRateRepoI interface {
+	Set(context.Context, *product_service.SetExchangeRateRequest) (*product_service.ExchangeRate, error)
+	GetList(context.Context, *product_service.GetExchangeRatesRequest) (*product_service.GetExchangeRatesResponse, error)
+	GetRates(ctx context.Context, at time.Time) (map[string]float64, error)
+}
+
+type UnitRepoI interface {
+	Create(context.Context, *product_service.CreateUnit) (*product_service.UnitPK, error)
+	GetByID(context.Context, *product_service.UnitPK) (*product_service.Unit, error)
+	GetList(context.Context, *product_service.GetListUnitRequest) (*product_service.GetListUnitResponse, error)
+	Update(context.Context, *product_service.UpdateUnit) (int64, error)
+	Delete(context.Context, *product_service.UnitPK) error
+}
+
+type PackagingRepoI interface {
+	Set(context.Context, *product_service.SetPackagingRequest) (*product_service.PackagingPK, error)
+	GetByID(context.Context, *product_service.PackagingPK) (*product_service.Packaging, error)
+	GetList(context.Context, *product_service.GetPackagingsRequest) (*product_service.GetPackagingsResponse, error)
+	Delete(context.Context, *product_service.PackagingPK) error
+}
+
+type BrandRepoI interface {
+	Create(context.Context, *product_service.CreateBrand) (*product_service.BrandPK, error)
+	GetByID(context.Context, *product_service.BrandPK) (*product_service.Brand, error)
+	GetList(context.Context, *product_service.GetListBrandRequest) (*product_service.GetListBrandResponse, error)
+	Update(context.Context, *product_service.UpdateBrand) (int64, error)
+	Delete(context.Context, *product_service.BrandPK) error
+}
+
+type ManufacturerRepoI interface {
+	Create(context.Context, *product_service.CreateManufacturer) (*product_service.ManufacturerPK, error)
+	GetByID(context.Context, *product_service.ManufacturerPK) (*product_service.Manufacturer, error)
+	GetList(context.Context, *product_service.GetListManufacturerRequest) (*product_service.GetListManufacturerResponse, error)
+	Update(context.Context, *product_service.UpdateManufacturer) (int64, error)
+	Delete(context.Context, *product_service.ManufacturerPK) error
+}
+
+type TagRepoI interface {
+	Create(context.Context, *product_service.CreateTag) (*product_service.TagPK, error)
+	GetByID(context.Context, *product_service.TagPK) (*product_service.Tag, error)
+	GetList(context.Context, *product_service.GetListTagRequest) (*product_service.GetListTagResponse, error)
+	Update(context.Context, *product_service.UpdateTag) (int64, error)
+	Delete(context.Context, *product_service.TagPK) error
+	TagProducts(context.Context, *product_service.TagProductsRequest) (int64, error)
+	UntagProducts(context.Context, *product_service.TagProductsRequest) (int64, error)
+}
+
+type CollectionRepoI interface {
+	Create(context.Context, *product_service.CreateCollection) (*product_service.CollectionPK, error)
+	GetByID(context.Context, *product_service.CollectionPK) (*product_service.Collection, error)
+	GetList(context.Context, *product_service.GetListCollectionRequest) (*product_service.GetListCollectionResponse, error)
+	Update(context.Context, *product_service.UpdateCollection) (int64, error)
+	Delete(context.Context, *product_service.CollectionPK) error
+}
+
+type BundleRepoI interface {
+	Set(context.Context, *product_service.SetBundleRequest) (int64, error)
+	GetComponents(ctx context.Context, bundleId string) ([]*product_service.BundleComponent, error)
+	GetComponentTotals(ctx context.Context, bundleIds []string) (map[string]float64, error)
+}
+
+type ProductRelationRepoI interface {
+	Set(context.Context, *product_service.SetProductRelationRequest) (*product_service.ProductRelationPK, error)
+	GetByID(context.Context, *product_service.ProductRelationPK) (*product_service.ProductRelation, error)
+	GetList(context.Context, *product_service.GetProductRelationsRequest) (*product_service.GetProductRelationsResponse, error)
+	GetRelatedProducts(ctx context.Context, productId string) ([]*product_service.RelatedProduct, error)
+	Delete(context.Context, *product_service.ProductRelationPK) error
+}
+
+type ProductTemplateRepoI interface {
+	Create(context.Context, *product_service.CreateProductTemplate) (*product_service.ProductTemplatePK, error)
+	GetByID(context.Context, *product_service.ProductTemplatePK) (*product_service.ProductTemplate, error)
+	GetByCategory(ctx context.Context, categoryId string) (*product_service.ProductTemplate, error)
+	GetList(context.Context, *product_service.GetListProductTemplateRequest) (*product_service.GetListProductTemplateResponse, error)
+	Update(context.Context, *product_service.UpdateProductTemplate) (int64, error)
+	Delete(context.Context, *product_service.ProductTemplatePK) error
+}
+
+type StockRepoI interface {
+	Get(context.Context, *product_service.GetStockRequest) (*product_service.Stock, error)
+	GetList(context.Context, *product_service.ListStockRequest) (*product_service.ListStockResponse, error)
+	Adjust(ctx context.Context, movements []*product_service.StockMovement, allowNegative bool) ([]*product_service.Stock, error)
+	GetMovements(context.Context, *product_service.ListMovementsRequest) (*product_service.ListMovementsResponse, error)
+	TakeSnapshots(ctx context.Context) (int64, error)
+	SetSetting(context.Context, *product_service.SetStockSettingRequest) (*product_service.StockSetting, error)
+	GetSetting(context.Context, *product_service.StockSettingPK) (*product_service.StockSetting, error)
+}
+
+type GoodsReceiptRepoI interface {
+	Create(context.Context, *product_service.CreateGoodsReceipt) (*product_service.GoodsReceiptPK, error)
+	GetByID(context.Context, *product_service.GoodsReceiptPK) (*product_service.GoodsReceipt, error)
+	GetList(context.Context, *product_service.GetListGoodsReceiptRequest) (*product_service.GetListGoodsReceiptResponse, error)
+	Update(context.Context, *product_service.UpdateGoodsReceipt) (int64, error)
+	Delete(context.Context, *product_service.GoodsReceiptPK) (int64, error)
+	Post(context.Context, *product_service.PostGoodsReceiptRequest) (int64, error)
+}
+
+type PurchaseOrderRepoI interface {
+	Create(context.Context, *product_service.CreatePurchaseOrder) (*product_service.PurchaseOrderPK, error)
+	GetByID(context.Context, *product_service.PurchaseOrderPK) (*product_service.PurchaseOrder, error)
+	GetList(context.Context, *product_service.GetListPurchaseOrderRequest) (*product_service.GetListPurchaseOrderResponse, error)
+	Send(context.Context, *product_service.PurchaseOrderPK) (int64, error)
+	Cancel(context.Context, *product_service.PurchaseOrderPK) (int64, error)
+	Receive(context.Context, *product_service.ReceivePurchaseOrderRequest) (string, error)
+	Reconcile(context.Context, *product_service.PurchaseOrderPK) ([]*product_service.ReconciliationLine, error)
+}